@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/samber/lo"
@@ -13,7 +16,9 @@ import (
 	"github.com/vlanse/glmr/internal/service/editor"
 	"github.com/vlanse/glmr/internal/service/gitlab"
 	"github.com/vlanse/glmr/internal/service/mr"
+	"github.com/vlanse/glmr/internal/service/store"
 	"github.com/vlanse/glmr/internal/util/config"
+	"github.com/vlanse/glmr/internal/util/request"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -27,6 +32,7 @@ type App struct {
 	gitlabSvc *gitlab.Service
 	mrSvc     *mr.Service
 	editorSvc *editor.Service
+	storeSvc  *store.Service
 }
 
 func NewApp() *App {
@@ -61,12 +67,20 @@ func (a *App) initConfig(_ context.Context) error {
 	return nil
 }
 
-func (a *App) initServices(_ context.Context) error {
+func (a *App) initServices(ctx context.Context) error {
 	cfg := a.cfgProvider.GetConfig()
 
-	a.gitlabSvc = gitlab.NewService(cfg.Gitlab.URL, cfg.Gitlab.Token)
+	historyPath, err := historyPath(cfg)
+	if err != nil {
+		return err
+	}
+	if a.storeSvc, err = store.NewService(ctx, historyPath); err != nil {
+		return fmt.Errorf("init history store: %w", err)
+	}
+
+	a.gitlabSvc = gitlab.NewService(gitlabSettings(cfg))
 
-	a.mrSvc = mr.NewService(a.gitlabSvc)
+	a.mrSvc = mr.NewService(a.gitlabSvc, a.storeSvc)
 
 	a.editorSvc = editor.NewService()
 
@@ -96,7 +110,11 @@ func (a *App) initAPI(ctx context.Context) error {
 	return nil
 }
 
-func (a *App) startBackgroundWorkers(_ context.Context) error {
+func (a *App) startBackgroundWorkers(ctx context.Context) error {
+	a.storeSvc.Start(ctx)
+
+	a.mrSvc.Start(ctx)
+
 	fmt.Printf("Web interface available at http://%s\n", httpServerEndpoint)
 	if err := runServer(a.grpcServer, a.mux); err != nil {
 		log.Fatal(err)
@@ -106,7 +124,11 @@ func (a *App) startBackgroundWorkers(_ context.Context) error {
 }
 
 func (a *App) updateConfig(cfg Config) {
+	// gitlab settings go first, since updating mr settings triggers refresh which should use them
+	a.gitlabSvc.UpdateSettings(gitlabSettings(cfg))
+
 	mrSettings := mr.Settings{
+		RefreshInterval: cfg.RefreshInterval,
 		JIRA: mr.JIRA{
 			URL: cfg.JIRA.URL,
 		},
@@ -117,10 +139,49 @@ func (a *App) updateConfig(cfg Config) {
 					return mr.ProjectSettings{
 						Name: item.Name,
 						ID:   item.ID,
+						SLA:  slaSettings(item.SLA),
 					}
 				}),
+				Namespaces: lo.Map(item.Namespaces, func(item Namespace, _ int) mr.NamespaceSettings {
+					return mr.NamespaceSettings{
+						Path:             item.Path,
+						IncludeSubgroups: item.IncludeSubgroups,
+						IncludeArchived:  item.IncludeArchived,
+						Include:          item.Include,
+						Exclude:          item.Exclude,
+					}
+				}),
+				SLA: slaSettings(item.SLA),
+			}
+		}),
+		DynamicGroups: lo.Map(cfg.DynamicGroups, func(item DynamicGroup, _ int) mr.DynamicGroupSettings {
+			return mr.DynamicGroupSettings{
+				Name: item.Name,
+				Type: mr.DynamicGroupType(item.Type),
+				SLA:  slaSettings(item.SLA),
+			}
+		}),
+		SLA: slaSettings(cfg.SLA),
+		Calendar: mr.CalendarSettings{
+			WorkingDays: lo.Map(cfg.Calendar.WorkingDays, func(item Weekday, _ int) time.Weekday {
+				return time.Weekday(item)
+			}),
+			Holidays: lo.Map(cfg.Calendar.Holidays, func(item Date, _ int) time.Time {
+				return time.Time(item)
+			}),
+		},
+		Rules: lo.Map(cfg.Rules, func(item Rule, _ int) mr.RuleSettings {
+			return mr.RuleSettings{
+				Name:       item.Name,
+				Expression: item.Expr,
+				Color:      item.Color,
+				Priority:   item.Priority,
 			}
 		}),
+		ReviewerSuggestions: mr.ReviewerSuggestionSettings{
+			Count:      cfg.ReviewerSuggestions.Count,
+			CodeOwners: cfg.ReviewerSuggestions.CodeOwners,
+		},
 	}
 	a.mrSvc.UpdateSettings(mrSettings)
 
@@ -143,5 +204,48 @@ func (a *App) updateConfig(cfg Config) {
 	}
 	a.editorSvc.UpdateSettings(editorSettings)
 
-	a.gitlabSvc.UpdateSettings(cfg.Gitlab.URL, cfg.Gitlab.Token)
+	a.storeSvc.UpdateSettings(store.Settings{
+		Retention: cfg.History.Retention,
+	})
+}
+
+// historyPath is configured path of history database or the default one in user config directory
+func historyPath(cfg Config) (string, error) {
+	if len(cfg.History.Path) > 0 {
+		return cfg.History.Path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("could not get user config directory, set history path explicitly: %w", err)
+	}
+	return filepath.Join(dir, "glmr", defaultHistoryFilename), nil
+}
+
+func slaSettings(sla *SLA) *mr.SLASettings {
+	if sla == nil {
+		return nil
+	}
+	return &mr.SLASettings{
+		MaxAge:             sla.MaxAge,
+		MaxIdle:            sla.MaxIdle,
+		MaxFirstReviewWait: sla.MaxFirstReviewWait,
+	}
+}
+
+func gitlabSettings(cfg Config) gitlab.Settings {
+	return gitlab.Settings{
+		URL:              cfg.Gitlab.URL,
+		Token:            cfg.Gitlab.Token,
+		MaxPages:         cfg.Gitlab.MaxPages,
+		GraphQLBatchSize: cfg.Gitlab.GraphQLBatchSize,
+		CacheTTL:         cfg.Gitlab.CacheTTL,
+		HTTP: request.Settings{
+			Timeout:        cfg.Gitlab.HTTP.Timeout,
+			MaxRetries:     cfg.Gitlab.HTTP.MaxRetries,
+			MinBackoff:     cfg.Gitlab.HTTP.MinBackoff,
+			MaxBackoff:     cfg.Gitlab.HTTP.MaxBackoff,
+			QPS:            cfg.Gitlab.HTTP.QPS,
+			MaxConcurrency: cfg.Gitlab.HTTP.MaxConcurrency,
+		},
+	}
 }
@@ -1,24 +1,106 @@
 package main
 
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
 const (
 	configFilename = "glmr-config.yaml"
+
+	defaultHistoryFilename = "history.db"
 )
 
+// SLA limits are measured in working time, see Calendar; omitted limit is inherited, zero disables it
+type SLA struct {
+	MaxAge             *time.Duration `yaml:"maxAge"`
+	MaxIdle            *time.Duration `yaml:"maxIdle"`
+	MaxFirstReviewWait *time.Duration `yaml:"maxFirstReviewWait"`
+}
+
+type Weekday time.Weekday
+
+func (w *Weekday) UnmarshalYAML(value *yaml.Node) error {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if name := d.String(); strings.EqualFold(value.Value, name) || strings.EqualFold(value.Value, name[:3]) {
+			*w = Weekday(d)
+			return nil
+		}
+	}
+	return fmt.Errorf("line %d: unknown day of week %q", value.Line, value.Value)
+}
+
+type Date time.Time
+
+func (d *Date) UnmarshalYAML(value *yaml.Node) error {
+	t, err := time.ParseInLocation(time.DateOnly, value.Value, time.Local)
+	if err != nil {
+		return fmt.Errorf("line %d: bad date %q, expected YYYY-MM-DD", value.Line, value.Value)
+	}
+	*d = Date(t)
+	return nil
+}
+
+type Calendar struct {
+	WorkingDays []Weekday `yaml:"workingDays"`
+	Holidays    []Date    `yaml:"holidays"`
+}
+
+// Rule highlights merge requests matching expression, i.e. `additions + deletions > 1000 && !mine`
+type Rule struct {
+	Name     string `yaml:"name"`
+	Expr     string `yaml:"expr"`
+	Color    string `yaml:"color"`
+	Priority int    `yaml:"priority"`
+}
+
 type Project struct {
 	ID   int64  `yaml:"id"`
 	Name string `yaml:"name"`
 	Path string `yaml:"path"`
+	SLA  *SLA   `yaml:"sla"`
+}
+
+type Namespace struct {
+	Path             string   `yaml:"path"`
+	IncludeSubgroups bool     `yaml:"includeSubgroups"`
+	IncludeArchived  bool     `yaml:"includeArchived"`
+	Include          []string `yaml:"include"`
+	Exclude          []string `yaml:"exclude"`
 }
 
 type Group struct {
-	Name     string    `yaml:"name"`
-	Projects []Project `yaml:"projects"`
+	Name       string      `yaml:"name"`
+	Projects   []Project   `yaml:"projects"`
+	Namespaces []Namespace `yaml:"namespaces"`
+	SLA        *SLA        `yaml:"sla"`
+}
+
+type DynamicGroup struct {
+	Name string `yaml:"name"`
+	// Type is one of: reviewRequested, assigned, authored
+	Type string `yaml:"type"`
+	SLA  *SLA   `yaml:"sla"`
 }
 
 type Config struct {
 	Gitlab struct {
-		URL   string `yaml:"url"`
-		Token string `yaml:"token"`
+		URL              string        `yaml:"url"`
+		Token            string        `yaml:"token"`
+		MaxPages         int           `yaml:"maxPages"`
+		GraphQLBatchSize int           `yaml:"graphqlBatchSize"`
+		CacheTTL         time.Duration `yaml:"cacheTTL"`
+		HTTP             struct {
+			Timeout        time.Duration `yaml:"timeout"`
+			MaxRetries     int           `yaml:"maxRetries"`
+			MinBackoff     time.Duration `yaml:"minBackoff"`
+			MaxBackoff     time.Duration `yaml:"maxBackoff"`
+			QPS            float64       `yaml:"qps"`
+			MaxConcurrency int           `yaml:"maxConcurrency"`
+		} `yaml:"http"`
 	} `yaml:"gitlab"`
 
 	JIRA struct {
@@ -29,5 +111,24 @@ type Config struct {
 		Cmd string `yaml:"cmd"`
 	}
 
+	RefreshInterval time.Duration `yaml:"refreshInterval"`
+
 	Groups []Group `yaml:"groups"`
+
+	DynamicGroups []DynamicGroup `yaml:"dynamicGroups"`
+
+	SLA      *SLA     `yaml:"sla"`
+	Calendar Calendar `yaml:"calendar"`
+
+	Rules []Rule `yaml:"rules"`
+
+	ReviewerSuggestions struct {
+		Count      int  `yaml:"count"`
+		CodeOwners bool `yaml:"codeOwners"`
+	} `yaml:"reviewerSuggestions"`
+
+	History struct {
+		Path      string        `yaml:"path"` // applied on restart only
+		Retention time.Duration `yaml:"retention"`
+	} `yaml:"history"`
 }
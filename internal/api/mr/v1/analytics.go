@@ -0,0 +1,167 @@
+package mr_v1
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/samber/lo"
+	api "github.com/vlanse/glmr/internal/pb/mr/v1"
+	"github.com/vlanse/glmr/internal/service/mr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultAnalyticsWindow = 7 * 24 * time.Hour
+
+func (s *Service) GetAnalytics(ctx context.Context, req *api.GetAnalyticsRequest) (*api.GetAnalyticsResponse, error) {
+	var from, to time.Time
+	if req.GetFrom() != nil {
+		from = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+
+	analytics, err := s.getAnalytics(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetAnalyticsResponse{
+		From:  timestamppb.New(analytics.From),
+		To:    timestamppb.New(analytics.To),
+		Total: toAnalyticsMetricsPB(analytics.Total),
+		Groups: lo.Map(analytics.Groups, func(item mr.NamedAnalyticsMetrics, _ int) *api.GetAnalyticsResponse_NamedMetrics {
+			return toNamedAnalyticsMetricsPB(item)
+		}),
+		People: lo.Map(analytics.People, func(item mr.NamedAnalyticsMetrics, _ int) *api.GetAnalyticsResponse_NamedMetrics {
+			return toNamedAnalyticsMetricsPB(item)
+		}),
+	}, nil
+}
+
+// serveAnalyticsCSV writes analytics as CSV table with totals, groups and people rows,
+// window is set with "from" and "to" query parameters as YYYY-MM-DD or RFC 3339 time
+func (s *Service) serveAnalyticsCSV(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	from, err := parseAnalyticsTime(r.URL.Query().Get("from"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("bad from: %v", err), http.StatusBadRequest)
+		return
+	}
+	to, err := parseAnalyticsTime(r.URL.Query().Get("to"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("bad to: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	analytics, err := s.getAnalytics(r.Context(), from, to)
+	if err != nil {
+		code := http.StatusInternalServerError
+		if status.Code(err) == codes.InvalidArgument {
+			code = http.StatusBadRequest
+		}
+		http.Error(w, status.Convert(err).Message(), code)
+		return
+	}
+
+	header := []string{
+		"scope", "name", "opened", "merged", "median_time_to_first_review_hours", "median_time_to_merge_hours", "reviews_given",
+	}
+	for _, b := range mr.SizeBuckets {
+		header = append(header, "size_"+string(b))
+	}
+	rows := [][]string{header, analyticsCSVRow("total", "", analytics.Total)}
+	for _, g := range analytics.Groups {
+		rows = append(rows, analyticsCSVRow("group", g.Name, g.Metrics))
+	}
+	for _, p := range analytics.People {
+		rows = append(rows, analyticsCSVRow("person", p.Name, p.Metrics))
+	}
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(
+		`attachment; filename="glmr-analytics-%s-%s.csv"`,
+		analytics.From.Format(time.DateOnly), analytics.To.Format(time.DateOnly),
+	))
+	_ = csv.NewWriter(w).WriteAll(rows)
+}
+
+// getAnalytics computes analytics over [from, to), zero to means now and zero from means defaultAnalyticsWindow before to
+func (s *Service) getAnalytics(ctx context.Context, from, to time.Time) (mr.Analytics, error) {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-defaultAnalyticsWindow)
+	}
+	if !from.Before(to) {
+		return mr.Analytics{}, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+
+	analytics, err := s.mrSvc.GetAnalytics(ctx, from, to)
+	if err != nil {
+		return mr.Analytics{}, status.Error(codes.Internal, err.Error())
+	}
+	return analytics, nil
+}
+
+func parseAnalyticsTime(value string) (time.Time, error) {
+	if len(value) == 0 {
+		return time.Time{}, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, time.Local); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func analyticsCSVRow(scope, name string, m mr.AnalyticsMetrics) []string {
+	hours := func(d time.Duration) string {
+		if d == 0 {
+			return ""
+		}
+		return strconv.FormatFloat(d.Hours(), 'f', 1, 64)
+	}
+
+	row := []string{
+		scope,
+		name,
+		strconv.Itoa(m.Opened),
+		strconv.Itoa(m.Merged),
+		hours(m.MedianTimeToFirstReview),
+		hours(m.MedianTimeToMerge),
+		strconv.Itoa(m.ReviewsGiven),
+	}
+	for _, b := range mr.SizeBuckets {
+		row = append(row, strconv.Itoa(m.Sizes[b]))
+	}
+	return row
+}
+
+func toNamedAnalyticsMetricsPB(item mr.NamedAnalyticsMetrics) *api.GetAnalyticsResponse_NamedMetrics {
+	return &api.GetAnalyticsResponse_NamedMetrics{
+		Name:    item.Name,
+		Metrics: toAnalyticsMetricsPB(item.Metrics),
+	}
+}
+
+func toAnalyticsMetricsPB(m mr.AnalyticsMetrics) *api.GetAnalyticsResponse_Metrics {
+	return &api.GetAnalyticsResponse_Metrics{
+		Opened:                  int32(m.Opened),
+		Merged:                  int32(m.Merged),
+		MedianTimeToFirstReview: toDurationPB(m.MedianTimeToFirstReview),
+		MedianTimeToMerge:       toDurationPB(m.MedianTimeToMerge),
+		ReviewsGiven:            int32(m.ReviewsGiven),
+		Sizes: lo.Map(mr.SizeBuckets, func(item mr.SizeBucket, _ int) *api.GetAnalyticsResponse_Metrics_Size {
+			return &api.GetAnalyticsResponse_Metrics_Size{
+				Bucket: string(item),
+				Count:  int32(m.Sizes[item]),
+			}
+		}),
+	}
+}
@@ -0,0 +1,19 @@
+package mr_v1
+
+import (
+	"context"
+
+	api "github.com/vlanse/glmr/internal/pb/mr/v1"
+)
+
+func (s *Service) SetPrivateNote(
+	ctx context.Context, req *api.SetPrivateNoteRequest,
+) (*api.MergeRequestActionResponse, error) {
+	return s.toLocalStateResponse(s.mrSvc.SetPrivateNote(ctx, req.GetProjectId(), req.GetIid(), req.GetNote()))
+}
+
+func (s *Service) SetTags(
+	ctx context.Context, req *api.SetTagsRequest,
+) (*api.MergeRequestActionResponse, error) {
+	return s.toLocalStateResponse(s.mrSvc.SetTags(ctx, req.GetProjectId(), req.GetIid(), req.GetTags()))
+}
@@ -0,0 +1,88 @@
+package mr_v1
+
+import (
+	"context"
+	"strings"
+
+	"github.com/samber/lo"
+	api "github.com/vlanse/glmr/internal/pb/mr/v1"
+	"github.com/vlanse/glmr/internal/service/mr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Service) GetDiscussions(ctx context.Context, req *api.GetDiscussionsRequest) (*api.GetDiscussionsResponse, error) {
+	discussions, err := s.mrSvc.GetDiscussions(ctx, req.GetProjectId(), req.GetIid())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	if req.GetSkipSystemNotes() {
+		discussions = lo.FilterMap(discussions, func(item mr.Discussion, _ int) (mr.Discussion, bool) {
+			item.Notes = lo.Reject(item.Notes, func(item mr.Note, _ int) bool {
+				return item.System
+			})
+			return item, len(item.Notes) > 0
+		})
+	}
+
+	return &api.GetDiscussionsResponse{
+		Discussions: lo.Map(discussions, func(item mr.Discussion, _ int) *api.Discussion {
+			return toDiscussionPB(item)
+		}),
+	}, nil
+}
+
+func (s *Service) AddComment(ctx context.Context, req *api.AddCommentRequest) (*api.DiscussionResponse, error) {
+	if len(strings.TrimSpace(req.GetBody())) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "comment body is empty")
+	}
+	return toDiscussionResponse(s.mrSvc.Comment(ctx, req.GetProjectId(), req.GetIid(), req.GetBody()))
+}
+
+func (s *Service) ReplyToDiscussion(ctx context.Context, req *api.ReplyToDiscussionRequest) (*api.DiscussionResponse, error) {
+	if len(strings.TrimSpace(req.GetBody())) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "reply body is empty")
+	}
+	return toDiscussionResponse(
+		s.mrSvc.ReplyToDiscussion(ctx, req.GetProjectId(), req.GetIid(), req.GetDiscussionId(), req.GetBody()),
+	)
+}
+
+func (s *Service) ResolveDiscussion(ctx context.Context, req *api.ResolveDiscussionRequest) (*api.DiscussionResponse, error) {
+	return toDiscussionResponse(
+		s.mrSvc.ResolveDiscussion(ctx, req.GetProjectId(), req.GetIid(), req.GetDiscussionId(), req.GetResolved()),
+	)
+}
+
+func toDiscussionResponse(item mr.Discussion, err error) (*api.DiscussionResponse, error) {
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &api.DiscussionResponse{Discussion: toDiscussionPB(item)}, nil
+}
+
+func toDiscussionPB(item mr.Discussion) *api.Discussion {
+	return &api.Discussion{
+		Id:         item.ID,
+		Resolvable: item.Resolvable,
+		Resolved:   item.Resolved,
+		Notes: lo.Map(item.Notes, func(item mr.Note, _ int) *api.Discussion_Note {
+			res := &api.Discussion_Note{
+				Id:         item.ID,
+				Author:     toUserPB(item.Author),
+				Body:       item.Body,
+				System:     item.System,
+				Resolvable: item.Resolvable,
+				Resolved:   item.Resolved,
+				CreatedAt:  timestamppb.New(item.CreatedAt),
+			}
+			if item.Resolved {
+				res.ResolvedBy = toUserPB(item.ResolvedBy)
+				res.ResolvedAt = timestamppb.New(item.ResolveAt)
+			}
+			return res
+		}),
+	}
+}
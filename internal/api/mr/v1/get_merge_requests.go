@@ -10,21 +10,49 @@ import (
 	"github.com/vlanse/glmr/internal/service/mr"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *Service) GetMergeRequests(ctx context.Context, req *api.GetMergeRequestsRequest) (*api.GetMergeRequestsResponse, error) {
-	mrg, err := s.mrSvc.GetMergeRequests(ctx, mr.Filter{
+	var conflict, hasUnresolved, seen, pinned *bool
+	if f := req.GetFilter(); f != nil {
+		conflict, hasUnresolved, seen, pinned = f.Conflict, f.HasUnresolved, f.Seen, f.Pinned
+	}
+
+	mrs, err := s.mrSvc.GetMergeRequests(ctx, mr.Filter{
 		SkipApprovedByMe: req.GetFilter().GetSkipApprovedByMe(),
 		ButStillShowMine: req.GetFilter().GetButStillShowMine(),
 		ShowOnlyMine:     req.GetFilter().GetShowOnlyMine(),
 		DoNotShowDrafts:  req.GetFilter().GetDoNotShowDrafts(),
-	})
+		Authors:          req.GetFilter().GetAuthors(),
+		Reviewers:        req.GetFilter().GetReviewers(),
+		Labels:           req.GetFilter().GetLabels(),
+		TargetBranches:   req.GetFilter().GetTargetBranches(),
+		PipelineStatuses: req.GetFilter().GetPipelineStatuses(),
+		Conflict:         conflict,
+		HasUnresolved:    hasUnresolved,
+		MinAge:           req.GetFilter().GetMinAge().AsDuration(),
+		MaxAge:           req.GetFilter().GetMaxAge().AsDuration(),
+		MinDiffSize:      req.GetFilter().GetMinDiffSize(),
+		MaxDiffSize:      req.GetFilter().GetMaxDiffSize(),
+		Text:             req.GetFilter().GetText(),
+		Seen:             seen,
+		Pinned:           pinned,
+		ShowSnoozed:      req.GetFilter().GetShowSnoozed(),
+		Tags:             req.GetFilter().GetTags(),
+	}, mr.Sort{
+		By:      sortBy(req.GetSort().GetBy()),
+		Reverse: req.GetSort().GetReverse(),
+	}, req.GetForceRefresh())
 	if err != nil {
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
 
 	res := &api.GetMergeRequestsResponse{
-		Groups: lo.Map(mrg, func(item mr.MergeRequestsGroup, _ int) *api.GetMergeRequestsResponse_Group {
+		UpdatedAt:          timestamppb.New(mrs.UpdatedAt),
+		SnapshotAgeSeconds: int64(time.Since(mrs.UpdatedAt).Seconds()),
+		Groups: lo.Map(mrs.Groups, func(item mr.MergeRequestsGroup, _ int) *api.GetMergeRequestsResponse_Group {
 			return &api.GetMergeRequestsResponse_Group{
 				Name: item.GroupName,
 				Summary: &api.GetMergeRequestsResponse_Group_Summary{
@@ -36,61 +64,230 @@ func (s *Service) GetMergeRequests(ctx context.Context, req *api.GetMergeRequest
 				MergeRequests: lo.Map(
 					item.MergeRequests,
 					func(item mr.MergeRequest, _ int) *api.GetMergeRequestsResponse_MergeRequest {
-						return &api.GetMergeRequestsResponse_MergeRequest{
-							Description: item.Description,
-							Iid:         item.IID,
-							Project: &api.GetMergeRequestsResponse_MergeRequest_Project{
-								Id:   item.Project.ID,
-								Name: item.Project.Name,
-								Url:  item.Project.WebURL,
-							},
-							Url: item.URL,
-							Author: &api.GetMergeRequestsResponse_MergeRequest_User{
-								Username:  item.Author.Username,
-								AvatarUrl: item.Author.AvatarURL,
-								Url:       item.Author.WebURL,
-								IsMe:      item.Author.IsMe,
-							},
-							ApprovedBy: lo.Map(item.Approvals, func(item mr.Approval, _ int) *api.GetMergeRequestsResponse_MergeRequest_User {
-								return &api.GetMergeRequestsResponse_MergeRequest_User{
-									Username:  item.User.Username,
-									AvatarUrl: item.User.AvatarURL,
-									Trusted:   item.User.IsOwner,
-									Url:       item.User.WebURL,
-									IsMe:      item.User.IsMe,
-								}
-							}),
-							Status: &api.GetMergeRequestsResponse_MergeRequest_Status{
-								PipelineFailed:  item.Status.PipelineFailed,
-								Conflict:        item.Status.Conflict,
-								Ready:           item.Status.Ready,
-								Outdated:        item.Status.Outdated,
-								Pending:         item.Status.Pending,
-								EditorAvailable: s.editorSvc.IsProjectConfigured(item.Project.ID),
-							},
-							Comments: &api.GetMergeRequestsResponse_MergeRequest_Comments{
-								ResolvedCount:   int32(item.CommentStats.ResolvedCount),
-								UnresolvedCount: int32(item.CommentStats.UnresolvedCount),
-							},
-							Age:            fmt.Sprintf("%dd", int(time.Since(item.CreatedAt).Hours()/24)),
-							ApprovedBefore: item.ApprovedBefore,
-							Issues: lo.Map(item.Issues, func(item mr.Issue, _ int) *api.GetMergeRequestsResponse_MergeRequest_Issue {
-								return &api.GetMergeRequestsResponse_MergeRequest_Issue{
-									Key: item.Key,
-									Url: item.URL,
-								}
-							}),
-							DiffStatsSummary: &api.GetMergeRequestsResponse_MergeRequest_DiffStatsSummary{
-								Additions: item.DiffStatsSummary.Additions,
-								Deletions: item.DiffStatsSummary.Deletions,
-								FileCount: item.DiffStatsSummary.FileCount,
-							},
-						}
+						return s.toMergeRequestPB(item)
 					},
 				),
+				Errors: lo.Map(item.FailedProjects, func(item mr.Project, _ int) *api.GetMergeRequestsResponse_Group_ProjectError {
+					res := &api.GetMergeRequestsResponse_Group_ProjectError{
+						ProjectId:   item.ID,
+						ProjectName: item.Name,
+						HttpStatus:  int32(item.Error.StatusCode),
+						Message:     item.Error.Message,
+					}
+					if !item.Error.LastSuccessAt.IsZero() {
+						res.LastSuccessAt = timestamppb.New(item.Error.LastSuccessAt)
+					}
+					return res
+				}),
 			}
 		}),
 	}
 
 	return res, nil
 }
+
+func (s *Service) toMergeRequestPB(item mr.MergeRequest) *api.GetMergeRequestsResponse_MergeRequest {
+	return &api.GetMergeRequestsResponse_MergeRequest{
+		Description: item.Description,
+		Iid:         item.IID,
+		Project: &api.GetMergeRequestsResponse_MergeRequest_Project{
+			Id:   item.Project.ID,
+			Name: item.Project.Name,
+			Url:  item.Project.WebURL,
+		},
+		Url:    item.URL,
+		Author: toUserPB(item.Author),
+		ApprovedBy: lo.Map(item.Approvals, func(item mr.Approval, _ int) *api.GetMergeRequestsResponse_MergeRequest_User {
+			return toUserPB(item.User)
+		}),
+		Status: &api.GetMergeRequestsResponse_MergeRequest_Status{
+			PipelineFailed:  item.Status.PipelineFailed,
+			Conflict:        item.Status.Conflict,
+			Ready:           item.Status.Ready,
+			Outdated:        item.Status.Outdated,
+			Pending:         item.Status.Pending,
+			EditorAvailable: s.editorSvc.IsProjectConfigured(item.Project.ID),
+			SlaViolations: lo.Map(item.Status.SLAViolations, func(item mr.SLAViolation, _ int) *api.GetMergeRequestsResponse_MergeRequest_Status_SLAViolation {
+				return &api.GetMergeRequestsResponse_MergeRequest_Status_SLAViolation{
+					Type:   slaViolationTypes[item.Type],
+					Limit:  durationpb.New(item.Limit),
+					Actual: durationpb.New(item.Actual),
+				}
+			}),
+		},
+		Comments: &api.GetMergeRequestsResponse_MergeRequest_Comments{
+			ResolvedCount:   int32(item.CommentStats.ResolvedCount),
+			UnresolvedCount: int32(item.CommentStats.UnresolvedCount),
+		},
+		Age:            fmt.Sprintf("%dd", int(time.Since(item.CreatedAt).Hours()/24)),
+		ApprovedBefore: item.ApprovedBefore,
+		Issues: lo.Map(item.Issues, func(item mr.Issue, _ int) *api.GetMergeRequestsResponse_MergeRequest_Issue {
+			return &api.GetMergeRequestsResponse_MergeRequest_Issue{
+				Key: item.Key,
+				Url: item.URL,
+			}
+		}),
+		DiffStatsSummary: &api.GetMergeRequestsResponse_MergeRequest_DiffStatsSummary{
+			Additions: item.DiffStatsSummary.Additions,
+			Deletions: item.DiffStatsSummary.Deletions,
+			FileCount: item.DiffStatsSummary.FileCount,
+		},
+		Approvals: &api.GetMergeRequestsResponse_MergeRequest_Approvals{
+			Required:       int32(item.ApprovalRequirements.Required),
+			Left:           int32(item.ApprovalRequirements.Left),
+			ApprovableByMe: item.ApprovalRequirements.ApprovableByMe,
+			Rules: lo.Map(
+				item.ApprovalRequirements.Rules,
+				func(item mr.ApprovalRule, _ int) *api.GetMergeRequestsResponse_MergeRequest_ApprovalRule {
+					return &api.GetMergeRequestsResponse_MergeRequest_ApprovalRule{
+						Name:              item.Name,
+						Type:              item.Type,
+						ApprovalsRequired: int32(item.ApprovalsRequired),
+						Approved:          item.Approved,
+						ApprovedBy: lo.Map(item.ApprovedBy, func(item mr.User, _ int) *api.GetMergeRequestsResponse_MergeRequest_User {
+							return toUserPB(item)
+						}),
+						EligibleApprovers: lo.Map(item.Users, func(item mr.User, _ int) *api.GetMergeRequestsResponse_MergeRequest_User {
+							return toUserPB(item)
+						}),
+					}
+				},
+			),
+		},
+		ApprovalsGiven: lo.Map(item.Approvals, func(item mr.Approval, _ int) *api.GetMergeRequestsResponse_MergeRequest_Approval {
+			res := &api.GetMergeRequestsResponse_MergeRequest_Approval{
+				User: toUserPB(item.User),
+			}
+			if !item.ApprovedAt.IsZero() {
+				res.ApprovedAt = timestamppb.New(item.ApprovedAt)
+			}
+			return res
+		}),
+		ReviewMetrics: &api.GetMergeRequestsResponse_MergeRequest_ReviewMetrics{
+			TimeToFirstComment:      toDurationPB(item.ReviewMetrics.TimeToFirstComment),
+			TimeToFirstApproval:     toDurationPB(item.ReviewMetrics.TimeToFirstApproval),
+			TimeToRequiredApprovals: toDurationPB(item.ReviewMetrics.TimeToRequiredApprovals),
+		},
+		Pipeline: &api.GetMergeRequestsResponse_MergeRequest_Pipeline{
+			Id:       item.Pipeline.ID,
+			Status:   item.Pipeline.Status,
+			Url:      item.Pipeline.WebURL,
+			Duration: toDurationPB(item.Pipeline.Duration),
+			FailedJobs: lo.Map(item.Pipeline.FailedJobs, func(item mr.Job, _ int) *api.GetMergeRequestsResponse_MergeRequest_Pipeline_Job {
+				return &api.GetMergeRequestsResponse_MergeRequest_Pipeline_Job{
+					Id:            item.ID,
+					Name:          item.Name,
+					Stage:         item.Stage,
+					Status:        item.Status,
+					Url:           item.WebURL,
+					Duration:      toDurationPB(item.Duration),
+					AllowFailure:  item.AllowFailure,
+					FailureReason: item.FailureReason,
+				}
+			}),
+		},
+		ReviewPriority: item.ReviewPriority,
+		Labels: lo.Map(item.Labels, func(item mr.Label, _ int) *api.GetMergeRequestsResponse_MergeRequest_Label {
+			return &api.GetMergeRequestsResponse_MergeRequest_Label{
+				Title: item.Title,
+				Color: item.Color,
+			}
+		}),
+		Milestone: toMilestonePB(item.Milestone),
+		Reviewers: lo.Map(item.Reviewers, func(item mr.User, _ int) *api.GetMergeRequestsResponse_MergeRequest_User {
+			return toUserPB(item)
+		}),
+		Assignees: lo.Map(item.Assignees, func(item mr.User, _ int) *api.GetMergeRequestsResponse_MergeRequest_User {
+			return toUserPB(item)
+		}),
+		SourceBranch: item.SourceBranch,
+		TargetBranch: item.TargetBranch,
+		Draft:        item.Draft,
+		SuggestedReviewers: lo.Map(item.SuggestedReviewers, func(item mr.ReviewerSuggestion, _ int) *api.GetMergeRequestsResponse_MergeRequest_ReviewerSuggestion {
+			return &api.GetMergeRequestsResponse_MergeRequest_ReviewerSuggestion{
+				User:          toUserPB(item.User),
+				Score:         item.Score,
+				OpenReviews:   int32(item.OpenReviews),
+				RecentReviews: int32(item.RecentReviews),
+				CodeOwner:     item.CodeOwner,
+			}
+		}),
+		Highlights: lo.Map(item.Highlights, func(item mr.Highlight, _ int) *api.GetMergeRequestsResponse_MergeRequest_Highlight {
+			return &api.GetMergeRequestsResponse_MergeRequest_Highlight{
+				Name:     item.Name,
+				Color:    item.Color,
+				Priority: int32(item.Priority),
+			}
+		}),
+		Marks:       toMarksPB(item.Marks),
+		PrivateNote: item.PrivateNote,
+		Tags:        item.Tags,
+		Warnings:    item.Warnings,
+	}
+}
+
+func toMarksPB(m mr.Marks) *api.GetMergeRequestsResponse_MergeRequest_Marks {
+	res := &api.GetMergeRequestsResponse_MergeRequest_Marks{
+		Seen:    m.Seen,
+		Pinned:  m.Pinned,
+		Snoozed: m.Snoozed,
+	}
+	if !m.SnoozedUntil.IsZero() {
+		res.SnoozedUntil = timestamppb.New(m.SnoozedUntil)
+	}
+	return res
+}
+
+var slaViolationTypes = map[mr.SLAViolationType]api.GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type{
+	mr.SLAViolationMaxAge:             api.GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_TYPE_MAX_AGE,
+	mr.SLAViolationMaxIdle:            api.GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_TYPE_MAX_IDLE,
+	mr.SLAViolationMaxFirstReviewWait: api.GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_TYPE_MAX_FIRST_REVIEW_WAIT,
+}
+
+func toDurationPB(d time.Duration) *durationpb.Duration {
+	if d == 0 {
+		return nil
+	}
+	return durationpb.New(d)
+}
+
+func toMilestonePB(m *mr.Milestone) *api.GetMergeRequestsResponse_MergeRequest_Milestone {
+	if m == nil {
+		return nil
+	}
+	res := &api.GetMergeRequestsResponse_MergeRequest_Milestone{
+		Title: m.Title,
+		Url:   m.WebURL,
+	}
+	if !m.DueDate.IsZero() {
+		res.DueDate = timestamppb.New(m.DueDate)
+	}
+	return res
+}
+
+func toUserPB(item mr.User) *api.GetMergeRequestsResponse_MergeRequest_User {
+	return &api.GetMergeRequestsResponse_MergeRequest_User{
+		Username:  item.Username,
+		AvatarUrl: item.AvatarURL,
+		Trusted:   item.IsOwner,
+		Url:       item.WebURL,
+		IsMe:      item.IsMe,
+	}
+}
+
+func sortBy(by api.GetMergeRequestsRequest_Sort_By) mr.SortBy {
+	switch by {
+	case api.GetMergeRequestsRequest_Sort_BY_LAST_ACTIVITY:
+		return mr.SortByLastActivity
+	case api.GetMergeRequestsRequest_Sort_BY_DIFF_SIZE:
+		return mr.SortByDiffSize
+	case api.GetMergeRequestsRequest_Sort_BY_UNRESOLVED:
+		return mr.SortByUnresolved
+	case api.GetMergeRequestsRequest_Sort_BY_APPROVALS_LEFT:
+		return mr.SortByApprovalsLeft
+	case api.GetMergeRequestsRequest_Sort_BY_REVIEW_PRIORITY:
+		return mr.SortByReviewPriority
+	default:
+		return mr.SortByAge
+	}
+}
@@ -0,0 +1,51 @@
+package mr_v1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	api "github.com/vlanse/glmr/internal/pb/mr/v1"
+	"github.com/vlanse/glmr/internal/service/mr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) MarkMergeRequestSeen(
+	ctx context.Context, req *api.MarkMergeRequestSeenRequest,
+) (*api.MergeRequestActionResponse, error) {
+	return s.toLocalStateResponse(s.mrSvc.MarkSeen(ctx, req.GetProjectId(), req.GetIid(), req.GetSeen()))
+}
+
+func (s *Service) SnoozeMergeRequest(
+	ctx context.Context, req *api.SnoozeMergeRequestRequest,
+) (*api.MergeRequestActionResponse, error) {
+	var until time.Time
+	if req.GetUntil() != nil {
+		until = req.GetUntil().AsTime()
+		if !until.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "snooze time is in the past")
+		}
+	}
+	return s.toLocalStateResponse(s.mrSvc.Snooze(ctx, req.GetProjectId(), req.GetIid(), until, req.GetUntilChange()))
+}
+
+func (s *Service) PinMergeRequest(
+	ctx context.Context, req *api.PinMergeRequestRequest,
+) (*api.MergeRequestActionResponse, error) {
+	return s.toLocalStateResponse(s.mrSvc.Pin(ctx, req.GetProjectId(), req.GetIid(), req.GetPinned()))
+}
+
+// toLocalStateResponse maps errors of local store instead of gitlab ones
+func (s *Service) toLocalStateResponse(item *mr.MergeRequest, err error) (*api.MergeRequestActionResponse, error) {
+	if errors.Is(err, mr.ErrNotShown) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, mr.ErrActivityUnknown) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return s.toActionResponse(item, nil)
+}
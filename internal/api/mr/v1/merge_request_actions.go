@@ -0,0 +1,71 @@
+package mr_v1
+
+import (
+	"context"
+	"strings"
+
+	api "github.com/vlanse/glmr/internal/pb/mr/v1"
+	"github.com/vlanse/glmr/internal/service/mr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) ApproveMergeRequest(
+	ctx context.Context, req *api.ApproveMergeRequestRequest,
+) (*api.MergeRequestActionResponse, error) {
+	return s.toActionResponse(s.mrSvc.Approve(ctx, req.GetProjectId(), req.GetIid()))
+}
+
+func (s *Service) UnapproveMergeRequest(
+	ctx context.Context, req *api.UnapproveMergeRequestRequest,
+) (*api.MergeRequestActionResponse, error) {
+	return s.toActionResponse(s.mrSvc.Unapprove(ctx, req.GetProjectId(), req.GetIid()))
+}
+
+func (s *Service) MergeMergeRequest(
+	ctx context.Context, req *api.MergeMergeRequestRequest,
+) (*api.MergeRequestActionResponse, error) {
+	return s.toActionResponse(s.mrSvc.Merge(ctx, req.GetProjectId(), req.GetIid(), mr.MergeOptions{
+		Squash:               req.GetSquash(),
+		RemoveSourceBranch:   req.GetRemoveSourceBranch(),
+		WhenPipelineSucceeds: req.GetWhenPipelineSucceeds(),
+	}))
+}
+
+func (s *Service) RebaseMergeRequest(
+	ctx context.Context, req *api.RebaseMergeRequestRequest,
+) (*api.MergeRequestActionResponse, error) {
+	return s.toActionResponse(s.mrSvc.Rebase(ctx, req.GetProjectId(), req.GetIid()))
+}
+
+func (s *Service) RetryPipeline(
+	ctx context.Context, req *api.RetryPipelineRequest,
+) (*api.MergeRequestActionResponse, error) {
+	return s.toActionResponse(s.mrSvc.RetryPipeline(ctx, req.GetProjectId(), req.GetIid(), req.GetPipelineId()))
+}
+
+func (s *Service) RetryJob(
+	ctx context.Context, req *api.RetryJobRequest,
+) (*api.MergeRequestActionResponse, error) {
+	return s.toActionResponse(s.mrSvc.RetryJob(ctx, req.GetProjectId(), req.GetIid(), req.GetJobId()))
+}
+
+func (s *Service) AssignReviewer(
+	ctx context.Context, req *api.AssignReviewerRequest,
+) (*api.MergeRequestActionResponse, error) {
+	if len(strings.TrimSpace(req.GetUsername())) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "reviewer username is empty")
+	}
+	return s.toActionResponse(s.mrSvc.AssignReviewer(ctx, req.GetProjectId(), req.GetIid(), req.GetUsername()))
+}
+
+func (s *Service) toActionResponse(item *mr.MergeRequest, err error) (*api.MergeRequestActionResponse, error) {
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	res := &api.MergeRequestActionResponse{}
+	if item != nil {
+		res.MergeRequest = s.toMergeRequestPB(*item)
+	}
+	return res, nil
+}
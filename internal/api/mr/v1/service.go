@@ -2,6 +2,7 @@ package mr_v1
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	api "github.com/vlanse/glmr/internal/pb/mr/v1"
@@ -32,5 +33,11 @@ func (s *Service) Register(
 	opts []grpc.DialOption,
 ) error {
 	api.RegisterMergeRequestsServer(srv, s)
-	return api.RegisterMergeRequestsHandlerFromEndpoint(ctx, mux, endpoint, opts)
+	if err := api.RegisterMergeRequestsHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		return err
+	}
+	if err := mux.HandlePath(http.MethodGet, "/mr/v1/events", s.serveEvents); err != nil {
+		return err
+	}
+	return mux.HandlePath(http.MethodGet, "/mr/v1/analytics.csv", s.serveAnalyticsCSV)
 }
@@ -0,0 +1,63 @@
+package mr_v1
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	api "github.com/vlanse/glmr/internal/pb/mr/v1"
+	"github.com/vlanse/glmr/internal/service/mr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var timelineChanges = map[mr.TimelineChange]api.GetMergeRequestTimelineResponse_Entry_Change{
+	mr.TimelineChangeState:     api.GetMergeRequestTimelineResponse_Entry_CHANGE_STATE,
+	mr.TimelineChangeTitle:     api.GetMergeRequestTimelineResponse_Entry_CHANGE_TITLE,
+	mr.TimelineChangeDraft:     api.GetMergeRequestTimelineResponse_Entry_CHANGE_DRAFT,
+	mr.TimelineChangeConflict:  api.GetMergeRequestTimelineResponse_Entry_CHANGE_CONFLICT,
+	mr.TimelineChangePipeline:  api.GetMergeRequestTimelineResponse_Entry_CHANGE_PIPELINE,
+	mr.TimelineChangeApprovals: api.GetMergeRequestTimelineResponse_Entry_CHANGE_APPROVALS,
+	mr.TimelineChangeThreads:   api.GetMergeRequestTimelineResponse_Entry_CHANGE_THREADS,
+	mr.TimelineChangeDiff:      api.GetMergeRequestTimelineResponse_Entry_CHANGE_DIFF,
+}
+
+func (s *Service) GetMergeRequestTimeline(
+	ctx context.Context, req *api.GetMergeRequestTimelineRequest,
+) (*api.GetMergeRequestTimelineResponse, error) {
+	timeline, err := s.mrSvc.GetTimeline(ctx, req.GetProjectId(), req.GetIid())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if timeline == nil {
+		return nil, status.Errorf(codes.NotFound, "no history of merge request %d in project %d", req.GetIid(), req.GetProjectId())
+	}
+
+	return &api.GetMergeRequestTimelineResponse{
+		ProjectId:   timeline.ProjectID,
+		Iid:         timeline.IID,
+		ProjectName: timeline.ProjectName,
+		Url:         timeline.URL,
+		Author:      timeline.Author,
+		CreatedAt:   timestamppb.New(timeline.CreatedAt),
+		Entries: lo.Map(timeline.Entries, func(item mr.TimelineEntry, _ int) *api.GetMergeRequestTimelineResponse_Entry {
+			return &api.GetMergeRequestTimelineResponse_Entry{
+				RecordedAt:        timestamppb.New(item.RecordedAt),
+				State:             item.State,
+				Title:             item.Title,
+				Draft:             item.Draft,
+				Conflict:          item.Conflict,
+				PipelineStatus:    item.PipelineStatus,
+				ApprovedBy:        item.ApprovedBy,
+				ApprovalsLeft:     int32(item.ApprovalsLeft),
+				Threads:           int32(item.Threads),
+				UnresolvedThreads: int32(item.UnresolvedThreads),
+				Additions:         item.Additions,
+				Deletions:         item.Deletions,
+				Changes: lo.Map(item.Changes, func(item mr.TimelineChange, _ int) api.GetMergeRequestTimelineResponse_Entry_Change {
+					return timelineChanges[item]
+				}),
+			}
+		}),
+	}, nil
+}
@@ -0,0 +1,94 @@
+package mr_v1
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/vlanse/glmr/internal/pb/mr/v1"
+	"github.com/vlanse/glmr/internal/service/mr"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var eventTypes = map[mr.EventType]api.MergeRequestEvent_Type{
+	mr.EventOpened:                api.MergeRequestEvent_TYPE_OPENED,
+	mr.EventClosed:                api.MergeRequestEvent_TYPE_CLOSED,
+	mr.EventMerged:                api.MergeRequestEvent_TYPE_MERGED,
+	mr.EventApproved:              api.MergeRequestEvent_TYPE_APPROVED,
+	mr.EventPipelineStatusChanged: api.MergeRequestEvent_TYPE_PIPELINE_STATUS_CHANGED,
+	mr.EventUnresolvedThreadAdded: api.MergeRequestEvent_TYPE_UNRESOLVED_THREAD_ADDED,
+	mr.EventConflictAppeared:      api.MergeRequestEvent_TYPE_CONFLICT_APPEARED,
+}
+
+func (s *Service) WatchMergeRequests(
+	_ *api.WatchMergeRequestsRequest, stream grpc.ServerStreamingServer[api.MergeRequestEvent],
+) error {
+	events, unsubscribe := s.mrSvc.Subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case e, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(s.toEventPB(e)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// serveEvents streams merge request events as server-sent events
+func (s *Service) serveEvents(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	flusher.Flush()
+
+	events, unsubscribe := s.mrSvc.Subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case e, ok := <-events:
+			if !ok {
+				return
+			}
+			data, err := protojson.Marshal(s.toEventPB(e))
+			if err != nil {
+				continue
+			}
+			if _, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func (s *Service) toEventPB(e mr.Event) *api.MergeRequestEvent {
+	res := &api.MergeRequestEvent{
+		Type:                   eventTypes[e.Type],
+		OccurredAt:             timestamppb.New(e.OccurredAt),
+		GroupName:              e.MergeRequest.Project.GroupName,
+		MergeRequest:           s.toMergeRequestPB(e.MergeRequest),
+		PipelineStatus:         e.MergeRequest.Pipeline.Status,
+		PreviousPipelineStatus: e.PreviousPipelineStatus,
+	}
+	if e.Type == mr.EventApproved {
+		res.User = toUserPB(e.User)
+	}
+	return res
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.6
+// 	protoc-gen-go v1.36.10
 // 	protoc        (unknown)
 // source: mr/v1/mr.proto
 
@@ -10,7 +10,8 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,9 +24,252 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type GetMergeRequestsRequest_Sort_By int32
+
+const (
+	GetMergeRequestsRequest_Sort_BY_AGE             GetMergeRequestsRequest_Sort_By = 0 // the oldest first
+	GetMergeRequestsRequest_Sort_BY_LAST_ACTIVITY   GetMergeRequestsRequest_Sort_By = 1 // the most recently updated first
+	GetMergeRequestsRequest_Sort_BY_DIFF_SIZE       GetMergeRequestsRequest_Sort_By = 2 // the smallest first
+	GetMergeRequestsRequest_Sort_BY_UNRESOLVED      GetMergeRequestsRequest_Sort_By = 3 // the most unresolved threads first
+	GetMergeRequestsRequest_Sort_BY_APPROVALS_LEFT  GetMergeRequestsRequest_Sort_By = 4 // the fewest approvals left first
+	GetMergeRequestsRequest_Sort_BY_REVIEW_PRIORITY GetMergeRequestsRequest_Sort_By = 5 // the highest review priority first
+)
+
+// Enum value maps for GetMergeRequestsRequest_Sort_By.
+var (
+	GetMergeRequestsRequest_Sort_By_name = map[int32]string{
+		0: "BY_AGE",
+		1: "BY_LAST_ACTIVITY",
+		2: "BY_DIFF_SIZE",
+		3: "BY_UNRESOLVED",
+		4: "BY_APPROVALS_LEFT",
+		5: "BY_REVIEW_PRIORITY",
+	}
+	GetMergeRequestsRequest_Sort_By_value = map[string]int32{
+		"BY_AGE":             0,
+		"BY_LAST_ACTIVITY":   1,
+		"BY_DIFF_SIZE":       2,
+		"BY_UNRESOLVED":      3,
+		"BY_APPROVALS_LEFT":  4,
+		"BY_REVIEW_PRIORITY": 5,
+	}
+)
+
+func (x GetMergeRequestsRequest_Sort_By) Enum() *GetMergeRequestsRequest_Sort_By {
+	p := new(GetMergeRequestsRequest_Sort_By)
+	*p = x
+	return p
+}
+
+func (x GetMergeRequestsRequest_Sort_By) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetMergeRequestsRequest_Sort_By) Descriptor() protoreflect.EnumDescriptor {
+	return file_mr_v1_mr_proto_enumTypes[0].Descriptor()
+}
+
+func (GetMergeRequestsRequest_Sort_By) Type() protoreflect.EnumType {
+	return &file_mr_v1_mr_proto_enumTypes[0]
+}
+
+func (x GetMergeRequestsRequest_Sort_By) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetMergeRequestsRequest_Sort_By.Descriptor instead.
+func (GetMergeRequestsRequest_Sort_By) EnumDescriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
+type GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type int32
+
+const (
+	GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_TYPE_UNSPECIFIED           GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type = 0
+	GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_TYPE_MAX_AGE               GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type = 1
+	GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_TYPE_MAX_IDLE              GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type = 2 // no activity on merge request
+	GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_TYPE_MAX_FIRST_REVIEW_WAIT GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type = 3
+)
+
+// Enum value maps for GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type.
+var (
+	GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_MAX_AGE",
+		2: "TYPE_MAX_IDLE",
+		3: "TYPE_MAX_FIRST_REVIEW_WAIT",
+	}
+	GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":           0,
+		"TYPE_MAX_AGE":               1,
+		"TYPE_MAX_IDLE":              2,
+		"TYPE_MAX_FIRST_REVIEW_WAIT": 3,
+	}
+)
+
+func (x GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type) Enum() *GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type {
+	p := new(GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type)
+	*p = x
+	return p
+}
+
+func (x GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_mr_v1_mr_proto_enumTypes[1].Descriptor()
+}
+
+func (GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type) Type() protoreflect.EnumType {
+	return &file_mr_v1_mr_proto_enumTypes[1]
+}
+
+func (x GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type.Descriptor instead.
+func (GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type) EnumDescriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 2, 0, 0}
+}
+
+type MergeRequestEvent_Type int32
+
+const (
+	MergeRequestEvent_TYPE_UNSPECIFIED             MergeRequestEvent_Type = 0
+	MergeRequestEvent_TYPE_OPENED                  MergeRequestEvent_Type = 1
+	MergeRequestEvent_TYPE_CLOSED                  MergeRequestEvent_Type = 2
+	MergeRequestEvent_TYPE_MERGED                  MergeRequestEvent_Type = 3
+	MergeRequestEvent_TYPE_APPROVED                MergeRequestEvent_Type = 4
+	MergeRequestEvent_TYPE_PIPELINE_STATUS_CHANGED MergeRequestEvent_Type = 5
+	MergeRequestEvent_TYPE_UNRESOLVED_THREAD_ADDED MergeRequestEvent_Type = 6
+	MergeRequestEvent_TYPE_CONFLICT_APPEARED       MergeRequestEvent_Type = 7
+)
+
+// Enum value maps for MergeRequestEvent_Type.
+var (
+	MergeRequestEvent_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_OPENED",
+		2: "TYPE_CLOSED",
+		3: "TYPE_MERGED",
+		4: "TYPE_APPROVED",
+		5: "TYPE_PIPELINE_STATUS_CHANGED",
+		6: "TYPE_UNRESOLVED_THREAD_ADDED",
+		7: "TYPE_CONFLICT_APPEARED",
+	}
+	MergeRequestEvent_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":             0,
+		"TYPE_OPENED":                  1,
+		"TYPE_CLOSED":                  2,
+		"TYPE_MERGED":                  3,
+		"TYPE_APPROVED":                4,
+		"TYPE_PIPELINE_STATUS_CHANGED": 5,
+		"TYPE_UNRESOLVED_THREAD_ADDED": 6,
+		"TYPE_CONFLICT_APPEARED":       7,
+	}
+)
+
+func (x MergeRequestEvent_Type) Enum() *MergeRequestEvent_Type {
+	p := new(MergeRequestEvent_Type)
+	*p = x
+	return p
+}
+
+func (x MergeRequestEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MergeRequestEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_mr_v1_mr_proto_enumTypes[2].Descriptor()
+}
+
+func (MergeRequestEvent_Type) Type() protoreflect.EnumType {
+	return &file_mr_v1_mr_proto_enumTypes[2]
+}
+
+func (x MergeRequestEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MergeRequestEvent_Type.Descriptor instead.
+func (MergeRequestEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type GetMergeRequestTimelineResponse_Entry_Change int32
+
+const (
+	GetMergeRequestTimelineResponse_Entry_CHANGE_UNSPECIFIED GetMergeRequestTimelineResponse_Entry_Change = 0
+	GetMergeRequestTimelineResponse_Entry_CHANGE_STATE       GetMergeRequestTimelineResponse_Entry_Change = 1
+	GetMergeRequestTimelineResponse_Entry_CHANGE_TITLE       GetMergeRequestTimelineResponse_Entry_Change = 2
+	GetMergeRequestTimelineResponse_Entry_CHANGE_DRAFT       GetMergeRequestTimelineResponse_Entry_Change = 3
+	GetMergeRequestTimelineResponse_Entry_CHANGE_CONFLICT    GetMergeRequestTimelineResponse_Entry_Change = 4
+	GetMergeRequestTimelineResponse_Entry_CHANGE_PIPELINE    GetMergeRequestTimelineResponse_Entry_Change = 5
+	GetMergeRequestTimelineResponse_Entry_CHANGE_APPROVALS   GetMergeRequestTimelineResponse_Entry_Change = 6
+	GetMergeRequestTimelineResponse_Entry_CHANGE_THREADS     GetMergeRequestTimelineResponse_Entry_Change = 7
+	GetMergeRequestTimelineResponse_Entry_CHANGE_DIFF        GetMergeRequestTimelineResponse_Entry_Change = 8
+)
+
+// Enum value maps for GetMergeRequestTimelineResponse_Entry_Change.
+var (
+	GetMergeRequestTimelineResponse_Entry_Change_name = map[int32]string{
+		0: "CHANGE_UNSPECIFIED",
+		1: "CHANGE_STATE",
+		2: "CHANGE_TITLE",
+		3: "CHANGE_DRAFT",
+		4: "CHANGE_CONFLICT",
+		5: "CHANGE_PIPELINE",
+		6: "CHANGE_APPROVALS",
+		7: "CHANGE_THREADS",
+		8: "CHANGE_DIFF",
+	}
+	GetMergeRequestTimelineResponse_Entry_Change_value = map[string]int32{
+		"CHANGE_UNSPECIFIED": 0,
+		"CHANGE_STATE":       1,
+		"CHANGE_TITLE":       2,
+		"CHANGE_DRAFT":       3,
+		"CHANGE_CONFLICT":    4,
+		"CHANGE_PIPELINE":    5,
+		"CHANGE_APPROVALS":   6,
+		"CHANGE_THREADS":     7,
+		"CHANGE_DIFF":        8,
+	}
+)
+
+func (x GetMergeRequestTimelineResponse_Entry_Change) Enum() *GetMergeRequestTimelineResponse_Entry_Change {
+	p := new(GetMergeRequestTimelineResponse_Entry_Change)
+	*p = x
+	return p
+}
+
+func (x GetMergeRequestTimelineResponse_Entry_Change) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetMergeRequestTimelineResponse_Entry_Change) Descriptor() protoreflect.EnumDescriptor {
+	return file_mr_v1_mr_proto_enumTypes[3].Descriptor()
+}
+
+func (GetMergeRequestTimelineResponse_Entry_Change) Type() protoreflect.EnumType {
+	return &file_mr_v1_mr_proto_enumTypes[3]
+}
+
+func (x GetMergeRequestTimelineResponse_Entry_Change) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetMergeRequestTimelineResponse_Entry_Change.Descriptor instead.
+func (GetMergeRequestTimelineResponse_Entry_Change) EnumDescriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{25, 0, 0}
+}
+
 type GetMergeRequestsRequest struct {
 	state         protoimpl.MessageState          `protogen:"open.v1"`
 	Filter        *GetMergeRequestsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	ForceRefresh  bool                            `protobuf:"varint,2,opt,name=forceRefresh,proto3" json:"forceRefresh,omitempty"` // fetch fresh data from gitlab instead of serving the latest snapshot
+	Sort          *GetMergeRequestsRequest_Sort   `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -67,28 +311,3271 @@ func (x *GetMergeRequestsRequest) GetFilter() *GetMergeRequestsRequest_Filter {
 	return nil
 }
 
+func (x *GetMergeRequestsRequest) GetForceRefresh() bool {
+	if x != nil {
+		return x.ForceRefresh
+	}
+	return false
+}
+
+func (x *GetMergeRequestsRequest) GetSort() *GetMergeRequestsRequest_Sort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
 type GetMergeRequestsResponse struct {
-	state         protoimpl.MessageState            `protogen:"open.v1"`
-	Groups        []*GetMergeRequestsResponse_Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	state              protoimpl.MessageState            `protogen:"open.v1"`
+	Groups             []*GetMergeRequestsResponse_Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp            `protobuf:"bytes,2,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"` // time of the snapshot the response is built from
+	SnapshotAgeSeconds int64                             `protobuf:"varint,3,opt,name=snapshotAgeSeconds,proto3" json:"snapshotAgeSeconds,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse) Reset() {
+	*x = GetMergeRequestsResponse{}
+	mi := &file_mr_v1_mr_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetMergeRequestsResponse) GetGroups() []*GetMergeRequestsResponse_Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse) GetSnapshotAgeSeconds() int64 {
+	if x != nil {
+		return x.SnapshotAgeSeconds
+	}
+	return 0
+}
+
+type WatchMergeRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchMergeRequestsRequest) Reset() {
+	*x = WatchMergeRequestsRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchMergeRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchMergeRequestsRequest) ProtoMessage() {}
+
+func (x *WatchMergeRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchMergeRequestsRequest.ProtoReflect.Descriptor instead.
+func (*WatchMergeRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{2}
+}
+
+type MergeRequestEvent struct {
+	state                  protoimpl.MessageState                      `protogen:"open.v1"`
+	Type                   MergeRequestEvent_Type                      `protobuf:"varint,1,opt,name=type,proto3,enum=mr.v1.MergeRequestEvent_Type" json:"type,omitempty"`
+	OccurredAt             *timestamppb.Timestamp                      `protobuf:"bytes,2,opt,name=occurredAt,proto3" json:"occurredAt,omitempty"`
+	GroupName              string                                      `protobuf:"bytes,3,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	MergeRequest           *GetMergeRequestsResponse_MergeRequest      `protobuf:"bytes,4,opt,name=mergeRequest,proto3" json:"mergeRequest,omitempty"`
+	User                   *GetMergeRequestsResponse_MergeRequest_User `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"` // approver, for approval events only
+	PipelineStatus         string                                      `protobuf:"bytes,6,opt,name=pipelineStatus,proto3" json:"pipelineStatus,omitempty"`
+	PreviousPipelineStatus string                                      `protobuf:"bytes,7,opt,name=previousPipelineStatus,proto3" json:"previousPipelineStatus,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MergeRequestEvent) Reset() {
+	*x = MergeRequestEvent{}
+	mi := &file_mr_v1_mr_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeRequestEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeRequestEvent) ProtoMessage() {}
+
+func (x *MergeRequestEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeRequestEvent.ProtoReflect.Descriptor instead.
+func (*MergeRequestEvent) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MergeRequestEvent) GetType() MergeRequestEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return MergeRequestEvent_TYPE_UNSPECIFIED
+}
+
+func (x *MergeRequestEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *MergeRequestEvent) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *MergeRequestEvent) GetMergeRequest() *GetMergeRequestsResponse_MergeRequest {
+	if x != nil {
+		return x.MergeRequest
+	}
+	return nil
+}
+
+func (x *MergeRequestEvent) GetUser() *GetMergeRequestsResponse_MergeRequest_User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *MergeRequestEvent) GetPipelineStatus() string {
+	if x != nil {
+		return x.PipelineStatus
+	}
+	return ""
+}
+
+func (x *MergeRequestEvent) GetPreviousPipelineStatus() string {
+	if x != nil {
+		return x.PreviousPipelineStatus
+	}
+	return ""
+}
+
+type ApproveMergeRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Iid           int64                  `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMergeRequestsResponse) Reset() {
-	*x = GetMergeRequestsResponse{}
-	mi := &file_mr_v1_mr_proto_msgTypes[1]
+func (x *ApproveMergeRequestRequest) Reset() {
+	*x = ApproveMergeRequestRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveMergeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveMergeRequestRequest) ProtoMessage() {}
+
+func (x *ApproveMergeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveMergeRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveMergeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ApproveMergeRequestRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *ApproveMergeRequestRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+type UnapproveMergeRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Iid           int64                  `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnapproveMergeRequestRequest) Reset() {
+	*x = UnapproveMergeRequestRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnapproveMergeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnapproveMergeRequestRequest) ProtoMessage() {}
+
+func (x *UnapproveMergeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnapproveMergeRequestRequest.ProtoReflect.Descriptor instead.
+func (*UnapproveMergeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UnapproveMergeRequestRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *UnapproveMergeRequestRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+type MergeMergeRequestRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId            int64                  `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Iid                  int64                  `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
+	Squash               bool                   `protobuf:"varint,3,opt,name=squash,proto3" json:"squash,omitempty"`
+	RemoveSourceBranch   bool                   `protobuf:"varint,4,opt,name=removeSourceBranch,proto3" json:"removeSourceBranch,omitempty"`
+	WhenPipelineSucceeds bool                   `protobuf:"varint,5,opt,name=whenPipelineSucceeds,proto3" json:"whenPipelineSucceeds,omitempty"` // schedule merge when pipeline is still running
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *MergeMergeRequestRequest) Reset() {
+	*x = MergeMergeRequestRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeMergeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeMergeRequestRequest) ProtoMessage() {}
+
+func (x *MergeMergeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeMergeRequestRequest.ProtoReflect.Descriptor instead.
+func (*MergeMergeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MergeMergeRequestRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *MergeMergeRequestRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+func (x *MergeMergeRequestRequest) GetSquash() bool {
+	if x != nil {
+		return x.Squash
+	}
+	return false
+}
+
+func (x *MergeMergeRequestRequest) GetRemoveSourceBranch() bool {
+	if x != nil {
+		return x.RemoveSourceBranch
+	}
+	return false
+}
+
+func (x *MergeMergeRequestRequest) GetWhenPipelineSucceeds() bool {
+	if x != nil {
+		return x.WhenPipelineSucceeds
+	}
+	return false
+}
+
+type RebaseMergeRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Iid           int64                  `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebaseMergeRequestRequest) Reset() {
+	*x = RebaseMergeRequestRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebaseMergeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebaseMergeRequestRequest) ProtoMessage() {}
+
+func (x *RebaseMergeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebaseMergeRequestRequest.ProtoReflect.Descriptor instead.
+func (*RebaseMergeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RebaseMergeRequestRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *RebaseMergeRequestRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+type RetryPipelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Iid           int64                  `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"` // merge request which is refreshed after retry
+	PipelineId    int64                  `protobuf:"varint,3,opt,name=pipelineId,proto3" json:"pipelineId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryPipelineRequest) Reset() {
+	*x = RetryPipelineRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPipelineRequest) ProtoMessage() {}
+
+func (x *RetryPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPipelineRequest.ProtoReflect.Descriptor instead.
+func (*RetryPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RetryPipelineRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *RetryPipelineRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+func (x *RetryPipelineRequest) GetPipelineId() int64 {
+	if x != nil {
+		return x.PipelineId
+	}
+	return 0
+}
+
+type RetryJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Iid           int64                  `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"` // merge request which is refreshed after retry
+	JobId         int64                  `protobuf:"varint,3,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryJobRequest) Reset() {
+	*x = RetryJobRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryJobRequest) ProtoMessage() {}
+
+func (x *RetryJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryJobRequest.ProtoReflect.Descriptor instead.
+func (*RetryJobRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RetryJobRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *RetryJobRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+func (x *RetryJobRequest) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type AssignReviewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Iid           int64                  `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignReviewerRequest) Reset() {
+	*x = AssignReviewerRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignReviewerRequest) ProtoMessage() {}
+
+func (x *AssignReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignReviewerRequest.ProtoReflect.Descriptor instead.
+func (*AssignReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AssignReviewerRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *AssignReviewerRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+func (x *AssignReviewerRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type MarkMergeRequestSeenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Iid           int64                  `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
+	Seen          bool                   `protobuf:"varint,3,opt,name=seen,proto3" json:"seen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkMergeRequestSeenRequest) Reset() {
+	*x = MarkMergeRequestSeenRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkMergeRequestSeenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkMergeRequestSeenRequest) ProtoMessage() {}
+
+func (x *MarkMergeRequestSeenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkMergeRequestSeenRequest.ProtoReflect.Descriptor instead.
+func (*MarkMergeRequestSeenRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MarkMergeRequestSeenRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *MarkMergeRequestSeenRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+func (x *MarkMergeRequestSeenRequest) GetSeen() bool {
+	if x != nil {
+		return x.Seen
+	}
+	return false
+}
+
+// SnoozeMergeRequestRequest wakes merge request up when neither until nor untilChange is set
+type SnoozeMergeRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Iid           int64                  `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	UntilChange   bool                   `protobuf:"varint,4,opt,name=untilChange,proto3" json:"untilChange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnoozeMergeRequestRequest) Reset() {
+	*x = SnoozeMergeRequestRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnoozeMergeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozeMergeRequestRequest) ProtoMessage() {}
+
+func (x *SnoozeMergeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoozeMergeRequestRequest.ProtoReflect.Descriptor instead.
+func (*SnoozeMergeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SnoozeMergeRequestRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *SnoozeMergeRequestRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+func (x *SnoozeMergeRequestRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *SnoozeMergeRequestRequest) GetUntilChange() bool {
+	if x != nil {
+		return x.UntilChange
+	}
+	return false
+}
+
+type PinMergeRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Iid           int64                  `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
+	Pinned        bool                   `protobuf:"varint,3,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinMergeRequestRequest) Reset() {
+	*x = PinMergeRequestRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinMergeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMergeRequestRequest) ProtoMessage() {}
+
+func (x *PinMergeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMergeRequestRequest.ProtoReflect.Descriptor instead.
+func (*PinMergeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PinMergeRequestRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *PinMergeRequestRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+func (x *PinMergeRequestRequest) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type SetPrivateNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Iid           int64                  `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"` // markdown
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrivateNoteRequest) Reset() {
+	*x = SetPrivateNoteRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrivateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrivateNoteRequest) ProtoMessage() {}
+
+func (x *SetPrivateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrivateNoteRequest.ProtoReflect.Descriptor instead.
+func (*SetPrivateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetPrivateNoteRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *SetPrivateNoteRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+func (x *SetPrivateNoteRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type SetTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Iid           int64                  `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTagsRequest) Reset() {
+	*x = SetTagsRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTagsRequest) ProtoMessage() {}
+
+func (x *SetTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTagsRequest.ProtoReflect.Descriptor instead.
+func (*SetTagsRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetTagsRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *SetTagsRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+func (x *SetTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type MergeRequestActionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// refreshed merge request, not set when it is not opened anymore or is not shown on dashboard
+	MergeRequest  *GetMergeRequestsResponse_MergeRequest `protobuf:"bytes,1,opt,name=mergeRequest,proto3" json:"mergeRequest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeRequestActionResponse) Reset() {
+	*x = MergeRequestActionResponse{}
+	mi := &file_mr_v1_mr_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeRequestActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeRequestActionResponse) ProtoMessage() {}
+
+func (x *MergeRequestActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeRequestActionResponse.ProtoReflect.Descriptor instead.
+func (*MergeRequestActionResponse) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MergeRequestActionResponse) GetMergeRequest() *GetMergeRequestsResponse_MergeRequest {
+	if x != nil {
+		return x.MergeRequest
+	}
+	return nil
+}
+
+type Discussion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Resolvable    bool                   `protobuf:"varint,2,opt,name=resolvable,proto3" json:"resolvable,omitempty"`
+	Resolved      bool                   `protobuf:"varint,3,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	Notes         []*Discussion_Note     `protobuf:"bytes,4,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Discussion) Reset() {
+	*x = Discussion{}
+	mi := &file_mr_v1_mr_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Discussion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discussion) ProtoMessage() {}
+
+func (x *Discussion) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discussion.ProtoReflect.Descriptor instead.
+func (*Discussion) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Discussion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Discussion) GetResolvable() bool {
+	if x != nil {
+		return x.Resolvable
+	}
+	return false
+}
+
+func (x *Discussion) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+func (x *Discussion) GetNotes() []*Discussion_Note {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type GetDiscussionsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId       int64                  `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Iid             int64                  `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
+	SkipSystemNotes bool                   `protobuf:"varint,3,opt,name=skipSystemNotes,proto3" json:"skipSystemNotes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetDiscussionsRequest) Reset() {
+	*x = GetDiscussionsRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiscussionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscussionsRequest) ProtoMessage() {}
+
+func (x *GetDiscussionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscussionsRequest.ProtoReflect.Descriptor instead.
+func (*GetDiscussionsRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetDiscussionsRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *GetDiscussionsRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+func (x *GetDiscussionsRequest) GetSkipSystemNotes() bool {
+	if x != nil {
+		return x.SkipSystemNotes
+	}
+	return false
+}
+
+type GetDiscussionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discussions   []*Discussion          `protobuf:"bytes,1,rep,name=discussions,proto3" json:"discussions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDiscussionsResponse) Reset() {
+	*x = GetDiscussionsResponse{}
+	mi := &file_mr_v1_mr_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiscussionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscussionsResponse) ProtoMessage() {}
+
+func (x *GetDiscussionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscussionsResponse.ProtoReflect.Descriptor instead.
+func (*GetDiscussionsResponse) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetDiscussionsResponse) GetDiscussions() []*Discussion {
+	if x != nil {
+		return x.Discussions
+	}
+	return nil
+}
+
+type AddCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Iid           int64                  `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCommentRequest) Reset() {
+	*x = AddCommentRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentRequest) ProtoMessage() {}
+
+func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddCommentRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AddCommentRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *AddCommentRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+func (x *AddCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type ReplyToDiscussionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Iid           int64                  `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
+	DiscussionId  string                 `protobuf:"bytes,3,opt,name=discussionId,proto3" json:"discussionId,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplyToDiscussionRequest) Reset() {
+	*x = ReplyToDiscussionRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyToDiscussionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyToDiscussionRequest) ProtoMessage() {}
+
+func (x *ReplyToDiscussionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyToDiscussionRequest.ProtoReflect.Descriptor instead.
+func (*ReplyToDiscussionRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReplyToDiscussionRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *ReplyToDiscussionRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+func (x *ReplyToDiscussionRequest) GetDiscussionId() string {
+	if x != nil {
+		return x.DiscussionId
+	}
+	return ""
+}
+
+func (x *ReplyToDiscussionRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type ResolveDiscussionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Iid           int64                  `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
+	DiscussionId  string                 `protobuf:"bytes,3,opt,name=discussionId,proto3" json:"discussionId,omitempty"`
+	Resolved      bool                   `protobuf:"varint,4,opt,name=resolved,proto3" json:"resolved,omitempty"` // false unresolves the thread
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveDiscussionRequest) Reset() {
+	*x = ResolveDiscussionRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveDiscussionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveDiscussionRequest) ProtoMessage() {}
+
+func (x *ResolveDiscussionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveDiscussionRequest.ProtoReflect.Descriptor instead.
+func (*ResolveDiscussionRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ResolveDiscussionRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *ResolveDiscussionRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+func (x *ResolveDiscussionRequest) GetDiscussionId() string {
+	if x != nil {
+		return x.DiscussionId
+	}
+	return ""
+}
+
+func (x *ResolveDiscussionRequest) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+type DiscussionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discussion    *Discussion            `protobuf:"bytes,1,opt,name=discussion,proto3" json:"discussion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscussionResponse) Reset() {
+	*x = DiscussionResponse{}
+	mi := &file_mr_v1_mr_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscussionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscussionResponse) ProtoMessage() {}
+
+func (x *DiscussionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscussionResponse.ProtoReflect.Descriptor instead.
+func (*DiscussionResponse) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DiscussionResponse) GetDiscussion() *Discussion {
+	if x != nil {
+		return x.Discussion
+	}
+	return nil
+}
+
+type GetMergeRequestTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Iid           int64                  `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestTimelineRequest) Reset() {
+	*x = GetMergeRequestTimelineRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestTimelineRequest) ProtoMessage() {}
+
+func (x *GetMergeRequestTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetMergeRequestTimelineRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *GetMergeRequestTimelineRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+type GetMergeRequestTimelineResponse struct {
+	state         protoimpl.MessageState                   `protogen:"open.v1"`
+	ProjectId     int64                                    `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Iid           int64                                    `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
+	ProjectName   string                                   `protobuf:"bytes,3,opt,name=projectName,proto3" json:"projectName,omitempty"`
+	Url           string                                   `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Author        string                                   `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	CreatedAt     *timestamppb.Timestamp                   `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Entries       []*GetMergeRequestTimelineResponse_Entry `protobuf:"bytes,7,rep,name=entries,proto3" json:"entries,omitempty"` // the oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestTimelineResponse) Reset() {
+	*x = GetMergeRequestTimelineResponse{}
+	mi := &file_mr_v1_mr_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestTimelineResponse) ProtoMessage() {}
+
+func (x *GetMergeRequestTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetMergeRequestTimelineResponse) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *GetMergeRequestTimelineResponse) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+func (x *GetMergeRequestTimelineResponse) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *GetMergeRequestTimelineResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetMergeRequestTimelineResponse) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *GetMergeRequestTimelineResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GetMergeRequestTimelineResponse) GetEntries() []*GetMergeRequestTimelineResponse_Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type GetAnalyticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"` // default is a week before "to"
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`     // exclusive, default is now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnalyticsRequest) Reset() {
+	*x = GetAnalyticsRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetAnalyticsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetAnalyticsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetAnalyticsResponse struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	From          *timestamppb.Timestamp               `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp               `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Total         *GetAnalyticsResponse_Metrics        `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	Groups        []*GetAnalyticsResponse_NamedMetrics `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"` // merge requests of group projects
+	People        []*GetAnalyticsResponse_NamedMetrics `protobuf:"bytes,5,rep,name=people,proto3" json:"people,omitempty"` // merge requests authored by person and reviews given
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnalyticsResponse) Reset() {
+	*x = GetAnalyticsResponse{}
+	mi := &file_mr_v1_mr_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetAnalyticsResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetAnalyticsResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetAnalyticsResponse) GetTotal() *GetAnalyticsResponse_Metrics {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *GetAnalyticsResponse) GetGroups() []*GetAnalyticsResponse_NamedMetrics {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetAnalyticsResponse) GetPeople() []*GetAnalyticsResponse_NamedMetrics {
+	if x != nil {
+		return x.People
+	}
+	return nil
+}
+
+type GetMergeRequestsRequest_Filter struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SkipApprovedByMe bool                   `protobuf:"varint,1,opt,name=skipApprovedByMe,proto3" json:"skipApprovedByMe,omitempty"`
+	ShowOnlyMine     bool                   `protobuf:"varint,2,opt,name=showOnlyMine,proto3" json:"showOnlyMine,omitempty"`
+	ButStillShowMine bool                   `protobuf:"varint,3,opt,name=butStillShowMine,proto3" json:"butStillShowMine,omitempty"` // when "skip approved by me" is enabled, this forces to include mine MRs in the list
+	DoNotShowDrafts  bool                   `protobuf:"varint,4,opt,name=doNotShowDrafts,proto3" json:"doNotShowDrafts,omitempty"`
+	// criteria below are combined with AND, values of list criteria are combined with OR, empty criteria are ignored
+	Authors          []string             `protobuf:"bytes,5,rep,name=authors,proto3" json:"authors,omitempty"`     // usernames
+	Reviewers        []string             `protobuf:"bytes,6,rep,name=reviewers,proto3" json:"reviewers,omitempty"` // usernames
+	Labels           []string             `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty"`
+	TargetBranches   []string             `protobuf:"bytes,8,rep,name=targetBranches,proto3" json:"targetBranches,omitempty"`
+	PipelineStatuses []string             `protobuf:"bytes,9,rep,name=pipelineStatuses,proto3" json:"pipelineStatuses,omitempty"` // lowercase gitlab pipeline statuses, i.e. "failed", "none" matches MRs without pipeline
+	Conflict         *bool                `protobuf:"varint,10,opt,name=conflict,proto3,oneof" json:"conflict,omitempty"`
+	HasUnresolved    *bool                `protobuf:"varint,11,opt,name=hasUnresolved,proto3,oneof" json:"hasUnresolved,omitempty"` // merge request has unresolved threads
+	MinAge           *durationpb.Duration `protobuf:"bytes,12,opt,name=minAge,proto3" json:"minAge,omitempty"`
+	MaxAge           *durationpb.Duration `protobuf:"bytes,13,opt,name=maxAge,proto3" json:"maxAge,omitempty"`
+	MinDiffSize      int64                `protobuf:"varint,14,opt,name=minDiffSize,proto3" json:"minDiffSize,omitempty"` // additions + deletions
+	MaxDiffSize      int64                `protobuf:"varint,15,opt,name=maxDiffSize,proto3" json:"maxDiffSize,omitempty"`
+	Text             string               `protobuf:"bytes,16,opt,name=text,proto3" json:"text,omitempty"` // case-insensitive search over title and description
+	Seen             *bool                `protobuf:"varint,17,opt,name=seen,proto3,oneof" json:"seen,omitempty"`
+	Pinned           *bool                `protobuf:"varint,18,opt,name=pinned,proto3,oneof" json:"pinned,omitempty"`
+	ShowSnoozed      bool                 `protobuf:"varint,19,opt,name=showSnoozed,proto3" json:"showSnoozed,omitempty"` // snoozed merge requests are hidden unless set
+	Tags             []string             `protobuf:"bytes,20,rep,name=tags,proto3" json:"tags,omitempty"`                // private tags
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsRequest_Filter) Reset() {
+	*x = GetMergeRequestsRequest_Filter{}
+	mi := &file_mr_v1_mr_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsRequest_Filter) ProtoMessage() {}
+
+func (x *GetMergeRequestsRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsRequest_Filter.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetSkipApprovedByMe() bool {
+	if x != nil {
+		return x.SkipApprovedByMe
+	}
+	return false
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetShowOnlyMine() bool {
+	if x != nil {
+		return x.ShowOnlyMine
+	}
+	return false
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetButStillShowMine() bool {
+	if x != nil {
+		return x.ButStillShowMine
+	}
+	return false
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetDoNotShowDrafts() bool {
+	if x != nil {
+		return x.DoNotShowDrafts
+	}
+	return false
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetAuthors() []string {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetReviewers() []string {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetTargetBranches() []string {
+	if x != nil {
+		return x.TargetBranches
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetPipelineStatuses() []string {
+	if x != nil {
+		return x.PipelineStatuses
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetConflict() bool {
+	if x != nil && x.Conflict != nil {
+		return *x.Conflict
+	}
+	return false
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetHasUnresolved() bool {
+	if x != nil && x.HasUnresolved != nil {
+		return *x.HasUnresolved
+	}
+	return false
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetMinAge() *durationpb.Duration {
+	if x != nil {
+		return x.MinAge
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetMinDiffSize() int64 {
+	if x != nil {
+		return x.MinDiffSize
+	}
+	return 0
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetMaxDiffSize() int64 {
+	if x != nil {
+		return x.MaxDiffSize
+	}
+	return 0
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetSeen() bool {
+	if x != nil && x.Seen != nil {
+		return *x.Seen
+	}
+	return false
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetPinned() bool {
+	if x != nil && x.Pinned != nil {
+		return *x.Pinned
+	}
+	return false
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetShowSnoozed() bool {
+	if x != nil {
+		return x.ShowSnoozed
+	}
+	return false
+}
+
+func (x *GetMergeRequestsRequest_Filter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Sort is an order of merge requests inside of each group, ties are ordered by age
+type GetMergeRequestsRequest_Sort struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	By            GetMergeRequestsRequest_Sort_By `protobuf:"varint,1,opt,name=by,proto3,enum=mr.v1.GetMergeRequestsRequest_Sort_By" json:"by,omitempty"`
+	Reverse       bool                            `protobuf:"varint,2,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsRequest_Sort) Reset() {
+	*x = GetMergeRequestsRequest_Sort{}
+	mi := &file_mr_v1_mr_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsRequest_Sort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsRequest_Sort) ProtoMessage() {}
+
+func (x *GetMergeRequestsRequest_Sort) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsRequest_Sort.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsRequest_Sort) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *GetMergeRequestsRequest_Sort) GetBy() GetMergeRequestsRequest_Sort_By {
+	if x != nil {
+		return x.By
+	}
+	return GetMergeRequestsRequest_Sort_BY_AGE
+}
+
+func (x *GetMergeRequestsRequest_Sort) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+type GetMergeRequestsResponse_MergeRequest struct {
+	state            protoimpl.MessageState                                  `protogen:"open.v1"`
+	Project          *GetMergeRequestsResponse_MergeRequest_Project          `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Url              string                                                  `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Description      string                                                  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Author           *GetMergeRequestsResponse_MergeRequest_User             `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	Status           *GetMergeRequestsResponse_MergeRequest_Status           `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	ApprovedBy       []*GetMergeRequestsResponse_MergeRequest_User           `protobuf:"bytes,6,rep,name=approvedBy,proto3" json:"approvedBy,omitempty"`
+	Comments         *GetMergeRequestsResponse_MergeRequest_Comments         `protobuf:"bytes,7,opt,name=comments,proto3" json:"comments,omitempty"`
+	Age              string                                                  `protobuf:"bytes,8,opt,name=age,proto3" json:"age,omitempty"`
+	ApprovedBefore   bool                                                    `protobuf:"varint,9,opt,name=approvedBefore,proto3" json:"approvedBefore,omitempty"`
+	Iid              int64                                                   `protobuf:"varint,10,opt,name=iid,proto3" json:"iid,omitempty"`
+	Issues           []*GetMergeRequestsResponse_MergeRequest_Issue          `protobuf:"bytes,11,rep,name=issues,proto3" json:"issues,omitempty"`
+	DiffStatsSummary *GetMergeRequestsResponse_MergeRequest_DiffStatsSummary `protobuf:"bytes,12,opt,name=diffStatsSummary,proto3" json:"diffStatsSummary,omitempty"`
+	Warnings         []string                                                `protobuf:"bytes,13,rep,name=warnings,proto3" json:"warnings,omitempty"` // details which could not be fetched from gitlab, merge request is shown without them
+	Approvals        *GetMergeRequestsResponse_MergeRequest_Approvals        `protobuf:"bytes,14,opt,name=approvals,proto3" json:"approvals,omitempty"`
+	ApprovalsGiven   []*GetMergeRequestsResponse_MergeRequest_Approval       `protobuf:"bytes,15,rep,name=approvalsGiven,proto3" json:"approvalsGiven,omitempty"`
+	ReviewMetrics    *GetMergeRequestsResponse_MergeRequest_ReviewMetrics    `protobuf:"bytes,16,opt,name=reviewMetrics,proto3" json:"reviewMetrics,omitempty"`
+	Pipeline         *GetMergeRequestsResponse_MergeRequest_Pipeline         `protobuf:"bytes,17,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Highlights       []*GetMergeRequestsResponse_MergeRequest_Highlight      `protobuf:"bytes,18,rep,name=highlights,proto3" json:"highlights,omitempty"` // sorted by priority, the highest first
+	// reviewPriority is how urgently the current user should review merge request, within [0, 100],
+	// it grows with staleness, being a required approver, small diff and green pipeline
+	ReviewPriority     float64                                                     `protobuf:"fixed64,19,opt,name=reviewPriority,proto3" json:"reviewPriority,omitempty"`
+	Labels             []*GetMergeRequestsResponse_MergeRequest_Label              `protobuf:"bytes,20,rep,name=labels,proto3" json:"labels,omitempty"`
+	Milestone          *GetMergeRequestsResponse_MergeRequest_Milestone            `protobuf:"bytes,21,opt,name=milestone,proto3" json:"milestone,omitempty"` // not set when merge request has no milestone
+	Reviewers          []*GetMergeRequestsResponse_MergeRequest_User               `protobuf:"bytes,22,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	Assignees          []*GetMergeRequestsResponse_MergeRequest_User               `protobuf:"bytes,23,rep,name=assignees,proto3" json:"assignees,omitempty"`
+	SourceBranch       string                                                      `protobuf:"bytes,24,opt,name=sourceBranch,proto3" json:"sourceBranch,omitempty"`
+	TargetBranch       string                                                      `protobuf:"bytes,25,opt,name=targetBranch,proto3" json:"targetBranch,omitempty"`
+	Draft              bool                                                        `protobuf:"varint,26,opt,name=draft,proto3" json:"draft,omitempty"`
+	SuggestedReviewers []*GetMergeRequestsResponse_MergeRequest_ReviewerSuggestion `protobuf:"bytes,27,rep,name=suggestedReviewers,proto3" json:"suggestedReviewers,omitempty"` // set for merge requests without review activity, the best first
+	Marks              *GetMergeRequestsResponse_MergeRequest_Marks                `protobuf:"bytes,28,opt,name=marks,proto3" json:"marks,omitempty"`
+	PrivateNote        string                                                      `protobuf:"bytes,29,opt,name=privateNote,proto3" json:"privateNote,omitempty"` // markdown note of the current user, kept locally
+	Tags               []string                                                    `protobuf:"bytes,30,rep,name=tags,proto3" json:"tags,omitempty"`               // free-form tags of the current user, kept locally, sorted
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest{}
+	mi := &file_mr_v1_mr_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse_MergeRequest) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse_MergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetProject() *GetMergeRequestsResponse_MergeRequest_Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetAuthor() *GetMergeRequestsResponse_MergeRequest_User {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetStatus() *GetMergeRequestsResponse_MergeRequest_Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetApprovedBy() []*GetMergeRequestsResponse_MergeRequest_User {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetComments() *GetMergeRequestsResponse_MergeRequest_Comments {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetAge() string {
+	if x != nil {
+		return x.Age
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetApprovedBefore() bool {
+	if x != nil {
+		return x.ApprovedBefore
+	}
+	return false
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetIid() int64 {
+	if x != nil {
+		return x.Iid
+	}
+	return 0
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetIssues() []*GetMergeRequestsResponse_MergeRequest_Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetDiffStatsSummary() *GetMergeRequestsResponse_MergeRequest_DiffStatsSummary {
+	if x != nil {
+		return x.DiffStatsSummary
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetApprovals() *GetMergeRequestsResponse_MergeRequest_Approvals {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetApprovalsGiven() []*GetMergeRequestsResponse_MergeRequest_Approval {
+	if x != nil {
+		return x.ApprovalsGiven
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetReviewMetrics() *GetMergeRequestsResponse_MergeRequest_ReviewMetrics {
+	if x != nil {
+		return x.ReviewMetrics
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetPipeline() *GetMergeRequestsResponse_MergeRequest_Pipeline {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetHighlights() []*GetMergeRequestsResponse_MergeRequest_Highlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetReviewPriority() float64 {
+	if x != nil {
+		return x.ReviewPriority
+	}
+	return 0
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetLabels() []*GetMergeRequestsResponse_MergeRequest_Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetMilestone() *GetMergeRequestsResponse_MergeRequest_Milestone {
+	if x != nil {
+		return x.Milestone
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetReviewers() []*GetMergeRequestsResponse_MergeRequest_User {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetAssignees() []*GetMergeRequestsResponse_MergeRequest_User {
+	if x != nil {
+		return x.Assignees
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetSourceBranch() string {
+	if x != nil {
+		return x.SourceBranch
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetTargetBranch() string {
+	if x != nil {
+		return x.TargetBranch
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetSuggestedReviewers() []*GetMergeRequestsResponse_MergeRequest_ReviewerSuggestion {
+	if x != nil {
+		return x.SuggestedReviewers
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetMarks() *GetMergeRequestsResponse_MergeRequest_Marks {
+	if x != nil {
+		return x.Marks
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetPrivateNote() string {
+	if x != nil {
+		return x.PrivateNote
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type GetMergeRequestsResponse_Group struct {
+	state         protoimpl.MessageState                         `protogen:"open.v1"`
+	Name          string                                         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MergeRequests []*GetMergeRequestsResponse_MergeRequest       `protobuf:"bytes,2,rep,name=mergeRequests,proto3" json:"mergeRequests,omitempty"`
+	Summary       *GetMergeRequestsResponse_Group_Summary        `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Errors        []*GetMergeRequestsResponse_Group_ProjectError `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_Group) Reset() {
+	*x = GetMergeRequestsResponse_Group{}
+	mi := &file_mr_v1_mr_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse_Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse_Group) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse_Group.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_Group) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *GetMergeRequestsResponse_Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_Group) GetMergeRequests() []*GetMergeRequestsResponse_MergeRequest {
+	if x != nil {
+		return x.MergeRequests
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_Group) GetSummary() *GetMergeRequestsResponse_Group_Summary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_Group) GetErrors() []*GetMergeRequestsResponse_Group_ProjectError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type GetMergeRequestsResponse_MergeRequest_User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,2,opt,name=avatarUrl,proto3" json:"avatarUrl,omitempty"`
+	Trusted       bool                   `protobuf:"varint,3,opt,name=trusted,proto3" json:"trusted,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	IsMe          bool                   `protobuf:"varint,5,opt,name=isMe,proto3" json:"isMe,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_User) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest_User{}
+	mi := &file_mr_v1_mr_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse_MergeRequest_User) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse_MergeRequest_User) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_User.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest_User) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 0}
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_User) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_User) GetTrusted() bool {
+	if x != nil {
+		return x.Trusted
+	}
+	return false
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_User) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_User) GetIsMe() bool {
+	if x != nil {
+		return x.IsMe
+	}
+	return false
+}
+
+type GetMergeRequestsResponse_MergeRequest_Project struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Project) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest_Project{}
+	mi := &file_mr_v1_mr_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse_MergeRequest_Project) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Project) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_Project.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest_Project) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 1}
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Project) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Project) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Project) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetMergeRequestsResponse_MergeRequest_Status struct {
+	state           protoimpl.MessageState                                       `protogen:"open.v1"`
+	Conflict        bool                                                         `protobuf:"varint,1,opt,name=conflict,proto3" json:"conflict,omitempty"`
+	PipelineFailed  bool                                                         `protobuf:"varint,2,opt,name=pipelineFailed,proto3" json:"pipelineFailed,omitempty"`
+	Ready           bool                                                         `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	Outdated        bool                                                         `protobuf:"varint,4,opt,name=outdated,proto3" json:"outdated,omitempty"` // some SLA is violated, see slaViolations for details
+	Pending         bool                                                         `protobuf:"varint,5,opt,name=pending,proto3" json:"pending,omitempty"`
+	EditorAvailable bool                                                         `protobuf:"varint,6,opt,name=editorAvailable,proto3" json:"editorAvailable,omitempty"`
+	SlaViolations   []*GetMergeRequestsResponse_MergeRequest_Status_SLAViolation `protobuf:"bytes,7,rep,name=slaViolations,proto3" json:"slaViolations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Status) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest_Status{}
+	mi := &file_mr_v1_mr_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse_MergeRequest_Status) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Status) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_Status.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest_Status) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 2}
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Status) GetConflict() bool {
+	if x != nil {
+		return x.Conflict
+	}
+	return false
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Status) GetPipelineFailed() bool {
+	if x != nil {
+		return x.PipelineFailed
+	}
+	return false
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Status) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Status) GetOutdated() bool {
+	if x != nil {
+		return x.Outdated
+	}
+	return false
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Status) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Status) GetEditorAvailable() bool {
+	if x != nil {
+		return x.EditorAvailable
+	}
+	return false
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Status) GetSlaViolations() []*GetMergeRequestsResponse_MergeRequest_Status_SLAViolation {
+	if x != nil {
+		return x.SlaViolations
+	}
+	return nil
+}
+
+type GetMergeRequestsResponse_MergeRequest_Comments struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UnresolvedCount int32                  `protobuf:"varint,1,opt,name=unresolvedCount,proto3" json:"unresolvedCount,omitempty"`
+	ResolvedCount   int32                  `protobuf:"varint,2,opt,name=resolvedCount,proto3" json:"resolvedCount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Comments) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest_Comments{}
+	mi := &file_mr_v1_mr_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Comments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse_MergeRequest_Comments) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Comments) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_Comments.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest_Comments) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 3}
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Comments) GetUnresolvedCount() int32 {
+	if x != nil {
+		return x.UnresolvedCount
+	}
+	return 0
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Comments) GetResolvedCount() int32 {
+	if x != nil {
+		return x.ResolvedCount
+	}
+	return 0
+}
+
+type GetMergeRequestsResponse_MergeRequest_Issue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Issue) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest_Issue{}
+	mi := &file_mr_v1_mr_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Issue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse_MergeRequest_Issue) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Issue) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_Issue.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest_Issue) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 4}
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Issue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Issue) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetMergeRequestsResponse_MergeRequest_DiffStatsSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Additions     int64                  `protobuf:"varint,1,opt,name=additions,proto3" json:"additions,omitempty"`
+	Deletions     int64                  `protobuf:"varint,2,opt,name=deletions,proto3" json:"deletions,omitempty"`
+	FileCount     int64                  `protobuf:"varint,3,opt,name=fileCount,proto3" json:"fileCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_DiffStatsSummary) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest_DiffStatsSummary{}
+	mi := &file_mr_v1_mr_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_DiffStatsSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse_MergeRequest_DiffStatsSummary) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse_MergeRequest_DiffStatsSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_DiffStatsSummary.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest_DiffStatsSummary) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 5}
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_DiffStatsSummary) GetAdditions() int64 {
+	if x != nil {
+		return x.Additions
+	}
+	return 0
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_DiffStatsSummary) GetDeletions() int64 {
+	if x != nil {
+		return x.Deletions
+	}
+	return 0
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_DiffStatsSummary) GetFileCount() int64 {
+	if x != nil {
+		return x.FileCount
+	}
+	return 0
+}
+
+type GetMergeRequestsResponse_MergeRequest_ApprovalRule struct {
+	state             protoimpl.MessageState                        `protogen:"open.v1"`
+	Name              string                                        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type              string                                        `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	ApprovalsRequired int32                                         `protobuf:"varint,3,opt,name=approvalsRequired,proto3" json:"approvalsRequired,omitempty"`
+	Approved          bool                                          `protobuf:"varint,4,opt,name=approved,proto3" json:"approved,omitempty"`
+	ApprovedBy        []*GetMergeRequestsResponse_MergeRequest_User `protobuf:"bytes,5,rep,name=approvedBy,proto3" json:"approvedBy,omitempty"`
+	EligibleApprovers []*GetMergeRequestsResponse_MergeRequest_User `protobuf:"bytes,6,rep,name=eligibleApprovers,proto3" json:"eligibleApprovers,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_ApprovalRule) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest_ApprovalRule{}
+	mi := &file_mr_v1_mr_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_ApprovalRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse_MergeRequest_ApprovalRule) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse_MergeRequest_ApprovalRule) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_ApprovalRule.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest_ApprovalRule) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 6}
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_ApprovalRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_ApprovalRule) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_ApprovalRule) GetApprovalsRequired() int32 {
+	if x != nil {
+		return x.ApprovalsRequired
+	}
+	return 0
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_ApprovalRule) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_ApprovalRule) GetApprovedBy() []*GetMergeRequestsResponse_MergeRequest_User {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_ApprovalRule) GetEligibleApprovers() []*GetMergeRequestsResponse_MergeRequest_User {
+	if x != nil {
+		return x.EligibleApprovers
+	}
+	return nil
+}
+
+type GetMergeRequestsResponse_MergeRequest_Approvals struct {
+	state          protoimpl.MessageState                                `protogen:"open.v1"`
+	Required       int32                                                 `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+	Left           int32                                                 `protobuf:"varint,2,opt,name=left,proto3" json:"left,omitempty"`
+	ApprovableByMe bool                                                  `protobuf:"varint,3,opt,name=approvableByMe,proto3" json:"approvableByMe,omitempty"`
+	Rules          []*GetMergeRequestsResponse_MergeRequest_ApprovalRule `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"` // empty when gitlab instance does not provide approval rules
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Approvals) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest_Approvals{}
+	mi := &file_mr_v1_mr_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Approvals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse_MergeRequest_Approvals) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Approvals) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_Approvals.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest_Approvals) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 7}
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Approvals) GetRequired() int32 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Approvals) GetLeft() int32 {
+	if x != nil {
+		return x.Left
+	}
+	return 0
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Approvals) GetApprovableByMe() bool {
+	if x != nil {
+		return x.ApprovableByMe
+	}
+	return false
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Approvals) GetRules() []*GetMergeRequestsResponse_MergeRequest_ApprovalRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type GetMergeRequestsResponse_MergeRequest_Approval struct {
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	User          *GetMergeRequestsResponse_MergeRequest_User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ApprovedAt    *timestamppb.Timestamp                      `protobuf:"bytes,2,opt,name=approvedAt,proto3" json:"approvedAt,omitempty"` // not set when approval time is unknown
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Approval) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest_Approval{}
+	mi := &file_mr_v1_mr_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Approval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse_MergeRequest_Approval) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Approval) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_Approval.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest_Approval) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 8}
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Approval) GetUser() *GetMergeRequestsResponse_MergeRequest_User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Approval) GetApprovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return nil
+}
+
+type GetMergeRequestsResponse_MergeRequest_Pipeline struct {
+	state         protoimpl.MessageState                                `protogen:"open.v1"`
+	Id            int64                                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 0 when merge request has no pipeline
+	Status        string                                                `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Url           string                                                `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Duration      *durationpb.Duration                                  `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	FailedJobs    []*GetMergeRequestsResponse_MergeRequest_Pipeline_Job `protobuf:"bytes,5,rep,name=failedJobs,proto3" json:"failedJobs,omitempty"` // set for failed pipelines only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest_Pipeline{}
+	mi := &file_mr_v1_mr_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse_MergeRequest_Pipeline) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_Pipeline.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest_Pipeline) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 9}
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline) GetFailedJobs() []*GetMergeRequestsResponse_MergeRequest_Pipeline_Job {
+	if x != nil {
+		return x.FailedJobs
+	}
+	return nil
+}
+
+type GetMergeRequestsResponse_MergeRequest_Label struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Color         string                 `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Label) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest_Label{}
+	mi := &file_mr_v1_mr_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Label) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse_MergeRequest_Label) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Label) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_Label.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest_Label) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 10}
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Label) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Label) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type GetMergeRequestsResponse_MergeRequest_Milestone struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=dueDate,proto3" json:"dueDate,omitempty"` // not set when milestone has no due date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Milestone) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest_Milestone{}
+	mi := &file_mr_v1_mr_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Milestone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse_MergeRequest_Milestone) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Milestone) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_Milestone.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest_Milestone) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 11}
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Milestone) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Milestone) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Milestone) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+// ReviewerSuggestion is a candidate reviewer, score grows with eligibility, CODEOWNERS match and recent reviews
+// of the project and is divided by current review load
+type GetMergeRequestsResponse_MergeRequest_ReviewerSuggestion struct {
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	User          *GetMergeRequestsResponse_MergeRequest_User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Score         float64                                     `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	OpenReviews   int32                                       `protobuf:"varint,3,opt,name=openReviews,proto3" json:"openReviews,omitempty"`     // opened merge requests where user is a reviewer
+	RecentReviews int32                                       `protobuf:"varint,4,opt,name=recentReviews,proto3" json:"recentReviews,omitempty"` // merge requests of the project reviewed by user recently
+	CodeOwner     bool                                        `protobuf:"varint,5,opt,name=codeOwner,proto3" json:"codeOwner,omitempty"`         // user owns changed files according to CODEOWNERS
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_ReviewerSuggestion) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest_ReviewerSuggestion{}
+	mi := &file_mr_v1_mr_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_ReviewerSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse_MergeRequest_ReviewerSuggestion) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse_MergeRequest_ReviewerSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_ReviewerSuggestion.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest_ReviewerSuggestion) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 12}
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_ReviewerSuggestion) GetUser() *GetMergeRequestsResponse_MergeRequest_User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_ReviewerSuggestion) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_ReviewerSuggestion) GetOpenReviews() int32 {
+	if x != nil {
+		return x.OpenReviews
+	}
+	return 0
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_ReviewerSuggestion) GetRecentReviews() int32 {
+	if x != nil {
+		return x.RecentReviews
+	}
+	return 0
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_ReviewerSuggestion) GetCodeOwner() bool {
+	if x != nil {
+		return x.CodeOwner
+	}
+	return false
+}
+
+// Marks are local state of merge request set by the current user
+type GetMergeRequestsResponse_MergeRequest_Marks struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seen          bool                   `protobuf:"varint,1,opt,name=seen,proto3" json:"seen,omitempty"` // marked as seen and has no new commits, comments or approvals since then
+	Pinned        bool                   `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Snoozed       bool                   `protobuf:"varint,3,opt,name=snoozed,proto3" json:"snoozed,omitempty"`
+	SnoozedUntil  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=snoozedUntil,proto3" json:"snoozedUntil,omitempty"` // not set when snoozed until the next change or not snoozed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Marks) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest_Marks{}
+	mi := &file_mr_v1_mr_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Marks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse_MergeRequest_Marks) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Marks) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_Marks.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest_Marks) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 13}
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Marks) GetSeen() bool {
+	if x != nil {
+		return x.Seen
+	}
+	return false
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Marks) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Marks) GetSnoozed() bool {
+	if x != nil {
+		return x.Snoozed
+	}
+	return false
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Marks) GetSnoozedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SnoozedUntil
+	}
+	return nil
+}
+
+// Highlight is assigned to merge request by user-defined rule
+type GetMergeRequestsResponse_MergeRequest_Highlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	Priority      int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Highlight) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest_Highlight{}
+	mi := &file_mr_v1_mr_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Highlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse_MergeRequest_Highlight) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Highlight) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_Highlight.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest_Highlight) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 14}
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Highlight) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Highlight) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Highlight) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+// ReviewMetrics are measured from merge request creation, not set when event has not happened yet
+type GetMergeRequestsResponse_MergeRequest_ReviewMetrics struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	TimeToFirstComment      *durationpb.Duration   `protobuf:"bytes,1,opt,name=timeToFirstComment,proto3" json:"timeToFirstComment,omitempty"`
+	TimeToFirstApproval     *durationpb.Duration   `protobuf:"bytes,2,opt,name=timeToFirstApproval,proto3" json:"timeToFirstApproval,omitempty"`
+	TimeToRequiredApprovals *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeToRequiredApprovals,proto3" json:"timeToRequiredApprovals,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_ReviewMetrics) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest_ReviewMetrics{}
+	mi := &file_mr_v1_mr_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMergeRequestsResponse) String() string {
+func (x *GetMergeRequestsResponse_MergeRequest_ReviewMetrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMergeRequestsResponse) ProtoMessage() {}
+func (*GetMergeRequestsResponse_MergeRequest_ReviewMetrics) ProtoMessage() {}
 
-func (x *GetMergeRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mr_v1_mr_proto_msgTypes[1]
+func (x *GetMergeRequestsResponse_MergeRequest_ReviewMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -99,43 +3586,57 @@ func (x *GetMergeRequestsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMergeRequestsResponse.ProtoReflect.Descriptor instead.
-func (*GetMergeRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_ReviewMetrics.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest_ReviewMetrics) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 15}
 }
 
-func (x *GetMergeRequestsResponse) GetGroups() []*GetMergeRequestsResponse_Group {
+func (x *GetMergeRequestsResponse_MergeRequest_ReviewMetrics) GetTimeToFirstComment() *durationpb.Duration {
 	if x != nil {
-		return x.Groups
+		return x.TimeToFirstComment
 	}
 	return nil
 }
 
-type GetMergeRequestsRequest_Filter struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	SkipApprovedByMe bool                   `protobuf:"varint,1,opt,name=skipApprovedByMe,proto3" json:"skipApprovedByMe,omitempty"`
-	ShowOnlyMine     bool                   `protobuf:"varint,2,opt,name=showOnlyMine,proto3" json:"showOnlyMine,omitempty"`
-	ButStillShowMine bool                   `protobuf:"varint,3,opt,name=butStillShowMine,proto3" json:"butStillShowMine,omitempty"` // when "skip approved by me" is enabled, this forces to include mine MRs in the list
-	DoNotShowDrafts  bool                   `protobuf:"varint,4,opt,name=doNotShowDrafts,proto3" json:"doNotShowDrafts,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *GetMergeRequestsResponse_MergeRequest_ReviewMetrics) GetTimeToFirstApproval() *durationpb.Duration {
+	if x != nil {
+		return x.TimeToFirstApproval
+	}
+	return nil
 }
 
-func (x *GetMergeRequestsRequest_Filter) Reset() {
-	*x = GetMergeRequestsRequest_Filter{}
-	mi := &file_mr_v1_mr_proto_msgTypes[2]
+func (x *GetMergeRequestsResponse_MergeRequest_ReviewMetrics) GetTimeToRequiredApprovals() *durationpb.Duration {
+	if x != nil {
+		return x.TimeToRequiredApprovals
+	}
+	return nil
+}
+
+// SLAViolation describes limit of merge request waiting time which is exceeded, durations are in working time
+type GetMergeRequestsResponse_MergeRequest_Status_SLAViolation struct {
+	state         protoimpl.MessageState                                         `protogen:"open.v1"`
+	Type          GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type `protobuf:"varint,1,opt,name=type,proto3,enum=mr.v1.GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type" json:"type,omitempty"`
+	Limit         *durationpb.Duration                                           `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Actual        *durationpb.Duration                                           `protobuf:"bytes,3,opt,name=actual,proto3" json:"actual,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_MergeRequest_Status_SLAViolation) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest_Status_SLAViolation{}
+	mi := &file_mr_v1_mr_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMergeRequestsRequest_Filter) String() string {
+func (x *GetMergeRequestsResponse_MergeRequest_Status_SLAViolation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMergeRequestsRequest_Filter) ProtoMessage() {}
+func (*GetMergeRequestsResponse_MergeRequest_Status_SLAViolation) ProtoMessage() {}
 
-func (x *GetMergeRequestsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_mr_v1_mr_proto_msgTypes[2]
+func (x *GetMergeRequestsResponse_MergeRequest_Status_SLAViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -146,72 +3647,61 @@ func (x *GetMergeRequestsRequest_Filter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMergeRequestsRequest_Filter.ProtoReflect.Descriptor instead.
-func (*GetMergeRequestsRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_mr_v1_mr_proto_rawDescGZIP(), []int{0, 0}
-}
-
-func (x *GetMergeRequestsRequest_Filter) GetSkipApprovedByMe() bool {
-	if x != nil {
-		return x.SkipApprovedByMe
-	}
-	return false
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_Status_SLAViolation.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest_Status_SLAViolation) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 2, 0}
 }
 
-func (x *GetMergeRequestsRequest_Filter) GetShowOnlyMine() bool {
+func (x *GetMergeRequestsResponse_MergeRequest_Status_SLAViolation) GetType() GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type {
 	if x != nil {
-		return x.ShowOnlyMine
+		return x.Type
 	}
-	return false
+	return GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_TYPE_UNSPECIFIED
 }
 
-func (x *GetMergeRequestsRequest_Filter) GetButStillShowMine() bool {
+func (x *GetMergeRequestsResponse_MergeRequest_Status_SLAViolation) GetLimit() *durationpb.Duration {
 	if x != nil {
-		return x.ButStillShowMine
+		return x.Limit
 	}
-	return false
+	return nil
 }
 
-func (x *GetMergeRequestsRequest_Filter) GetDoNotShowDrafts() bool {
+func (x *GetMergeRequestsResponse_MergeRequest_Status_SLAViolation) GetActual() *durationpb.Duration {
 	if x != nil {
-		return x.DoNotShowDrafts
+		return x.Actual
 	}
-	return false
+	return nil
 }
 
-type GetMergeRequestsResponse_MergeRequest struct {
-	state            protoimpl.MessageState                                  `protogen:"open.v1"`
-	Project          *GetMergeRequestsResponse_MergeRequest_Project          `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
-	Url              string                                                  `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Description      string                                                  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Author           *GetMergeRequestsResponse_MergeRequest_User             `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
-	Status           *GetMergeRequestsResponse_MergeRequest_Status           `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	ApprovedBy       []*GetMergeRequestsResponse_MergeRequest_User           `protobuf:"bytes,6,rep,name=approvedBy,proto3" json:"approvedBy,omitempty"`
-	Comments         *GetMergeRequestsResponse_MergeRequest_Comments         `protobuf:"bytes,7,opt,name=comments,proto3" json:"comments,omitempty"`
-	Age              string                                                  `protobuf:"bytes,8,opt,name=age,proto3" json:"age,omitempty"`
-	ApprovedBefore   bool                                                    `protobuf:"varint,9,opt,name=approvedBefore,proto3" json:"approvedBefore,omitempty"`
-	Iid              int64                                                   `protobuf:"varint,10,opt,name=iid,proto3" json:"iid,omitempty"`
-	Issues           []*GetMergeRequestsResponse_MergeRequest_Issue          `protobuf:"bytes,11,rep,name=issues,proto3" json:"issues,omitempty"`
-	DiffStatsSummary *GetMergeRequestsResponse_MergeRequest_DiffStatsSummary `protobuf:"bytes,12,opt,name=diffStatsSummary,proto3" json:"diffStatsSummary,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type GetMergeRequestsResponse_MergeRequest_Pipeline_Job struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Stage         string                 `protobuf:"bytes,3,opt,name=stage,proto3" json:"stage,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	AllowFailure  bool                   `protobuf:"varint,7,opt,name=allowFailure,proto3" json:"allowFailure,omitempty"`
+	FailureReason string                 `protobuf:"bytes,8,opt,name=failureReason,proto3" json:"failureReason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest) Reset() {
-	*x = GetMergeRequestsResponse_MergeRequest{}
-	mi := &file_mr_v1_mr_proto_msgTypes[3]
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline_Job) Reset() {
+	*x = GetMergeRequestsResponse_MergeRequest_Pipeline_Job{}
+	mi := &file_mr_v1_mr_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest) String() string {
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline_Job) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMergeRequestsResponse_MergeRequest) ProtoMessage() {}
+func (*GetMergeRequestsResponse_MergeRequest_Pipeline_Job) ProtoMessage() {}
 
-func (x *GetMergeRequestsResponse_MergeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mr_v1_mr_proto_msgTypes[3]
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline_Job) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -222,119 +3712,162 @@ func (x *GetMergeRequestsResponse_MergeRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMergeRequestsResponse_MergeRequest.ProtoReflect.Descriptor instead.
-func (*GetMergeRequestsResponse_MergeRequest) Descriptor() ([]byte, []int) {
-	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use GetMergeRequestsResponse_MergeRequest_Pipeline_Job.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_MergeRequest_Pipeline_Job) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 9, 0}
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest) GetProject() *GetMergeRequestsResponse_MergeRequest_Project {
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline_Job) GetId() int64 {
 	if x != nil {
-		return x.Project
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest) GetUrl() string {
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline_Job) GetName() string {
 	if x != nil {
-		return x.Url
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest) GetDescription() string {
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline_Job) GetStage() string {
 	if x != nil {
-		return x.Description
+		return x.Stage
 	}
 	return ""
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest) GetAuthor() *GetMergeRequestsResponse_MergeRequest_User {
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline_Job) GetStatus() string {
 	if x != nil {
-		return x.Author
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest) GetStatus() *GetMergeRequestsResponse_MergeRequest_Status {
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline_Job) GetUrl() string {
 	if x != nil {
-		return x.Status
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest) GetApprovedBy() []*GetMergeRequestsResponse_MergeRequest_User {
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline_Job) GetDuration() *durationpb.Duration {
 	if x != nil {
-		return x.ApprovedBy
+		return x.Duration
 	}
 	return nil
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest) GetComments() *GetMergeRequestsResponse_MergeRequest_Comments {
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline_Job) GetAllowFailure() bool {
 	if x != nil {
-		return x.Comments
+		return x.AllowFailure
 	}
-	return nil
+	return false
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest) GetAge() string {
+func (x *GetMergeRequestsResponse_MergeRequest_Pipeline_Job) GetFailureReason() string {
 	if x != nil {
-		return x.Age
+		return x.FailureReason
 	}
 	return ""
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest) GetApprovedBefore() bool {
+type GetMergeRequestsResponse_Group_Summary struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Total          int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Visible        int32                  `protobuf:"varint,2,opt,name=visible,proto3" json:"visible,omitempty"`
+	Overdue        int32                  `protobuf:"varint,3,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	OverdueVisible int32                  `protobuf:"varint,4,opt,name=overdueVisible,proto3" json:"overdueVisible,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetMergeRequestsResponse_Group_Summary) Reset() {
+	*x = GetMergeRequestsResponse_Group_Summary{}
+	mi := &file_mr_v1_mr_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMergeRequestsResponse_Group_Summary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeRequestsResponse_Group_Summary) ProtoMessage() {}
+
+func (x *GetMergeRequestsResponse_Group_Summary) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[50]
 	if x != nil {
-		return x.ApprovedBefore
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest) GetIid() int64 {
+// Deprecated: Use GetMergeRequestsResponse_Group_Summary.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_Group_Summary) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 1, 0}
+}
+
+func (x *GetMergeRequestsResponse_Group_Summary) GetTotal() int32 {
 	if x != nil {
-		return x.Iid
+		return x.Total
 	}
 	return 0
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest) GetIssues() []*GetMergeRequestsResponse_MergeRequest_Issue {
+func (x *GetMergeRequestsResponse_Group_Summary) GetVisible() int32 {
 	if x != nil {
-		return x.Issues
+		return x.Visible
 	}
-	return nil
+	return 0
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest) GetDiffStatsSummary() *GetMergeRequestsResponse_MergeRequest_DiffStatsSummary {
+func (x *GetMergeRequestsResponse_Group_Summary) GetOverdue() int32 {
 	if x != nil {
-		return x.DiffStatsSummary
+		return x.Overdue
 	}
-	return nil
+	return 0
 }
 
-type GetMergeRequestsResponse_Group struct {
-	state         protoimpl.MessageState                   `protogen:"open.v1"`
-	Name          string                                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	MergeRequests []*GetMergeRequestsResponse_MergeRequest `protobuf:"bytes,2,rep,name=mergeRequests,proto3" json:"mergeRequests,omitempty"`
-	Summary       *GetMergeRequestsResponse_Group_Summary  `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+func (x *GetMergeRequestsResponse_Group_Summary) GetOverdueVisible() int32 {
+	if x != nil {
+		return x.OverdueVisible
+	}
+	return 0
+}
+
+// ProjectError describes project of the group which could not be fetched from gitlab
+type GetMergeRequestsResponse_Group_ProjectError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	ProjectName   string                 `protobuf:"bytes,2,opt,name=projectName,proto3" json:"projectName,omitempty"`
+	HttpStatus    int32                  `protobuf:"varint,3,opt,name=httpStatus,proto3" json:"httpStatus,omitempty"` // 0 when failure is not related to HTTP, i.e. network error
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	LastSuccessAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=lastSuccessAt,proto3" json:"lastSuccessAt,omitempty"` // not set when project was never fetched successfully
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMergeRequestsResponse_Group) Reset() {
-	*x = GetMergeRequestsResponse_Group{}
-	mi := &file_mr_v1_mr_proto_msgTypes[4]
+func (x *GetMergeRequestsResponse_Group_ProjectError) Reset() {
+	*x = GetMergeRequestsResponse_Group_ProjectError{}
+	mi := &file_mr_v1_mr_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMergeRequestsResponse_Group) String() string {
+func (x *GetMergeRequestsResponse_Group_ProjectError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMergeRequestsResponse_Group) ProtoMessage() {}
+func (*GetMergeRequestsResponse_Group_ProjectError) ProtoMessage() {}
 
-func (x *GetMergeRequestsResponse_Group) ProtoReflect() protoreflect.Message {
-	mi := &file_mr_v1_mr_proto_msgTypes[4]
+func (x *GetMergeRequestsResponse_Group_ProjectError) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -345,58 +3878,76 @@ func (x *GetMergeRequestsResponse_Group) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMergeRequestsResponse_Group.ProtoReflect.Descriptor instead.
-func (*GetMergeRequestsResponse_Group) Descriptor() ([]byte, []int) {
-	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 1}
+// Deprecated: Use GetMergeRequestsResponse_Group_ProjectError.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestsResponse_Group_ProjectError) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 1, 1}
 }
 
-func (x *GetMergeRequestsResponse_Group) GetName() string {
+func (x *GetMergeRequestsResponse_Group_ProjectError) GetProjectId() int64 {
 	if x != nil {
-		return x.Name
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *GetMergeRequestsResponse_Group_ProjectError) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
 	}
 	return ""
 }
 
-func (x *GetMergeRequestsResponse_Group) GetMergeRequests() []*GetMergeRequestsResponse_MergeRequest {
+func (x *GetMergeRequestsResponse_Group_ProjectError) GetHttpStatus() int32 {
 	if x != nil {
-		return x.MergeRequests
+		return x.HttpStatus
 	}
-	return nil
+	return 0
 }
 
-func (x *GetMergeRequestsResponse_Group) GetSummary() *GetMergeRequestsResponse_Group_Summary {
+func (x *GetMergeRequestsResponse_Group_ProjectError) GetMessage() string {
 	if x != nil {
-		return x.Summary
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetMergeRequestsResponse_Group_ProjectError) GetLastSuccessAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSuccessAt
 	}
 	return nil
 }
 
-type GetMergeRequestsResponse_MergeRequest_User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	AvatarUrl     string                 `protobuf:"bytes,2,opt,name=avatarUrl,proto3" json:"avatarUrl,omitempty"`
-	Trusted       bool                   `protobuf:"varint,3,opt,name=trusted,proto3" json:"trusted,omitempty"`
-	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
-	IsMe          bool                   `protobuf:"varint,5,opt,name=isMe,proto3" json:"isMe,omitempty"`
+type Discussion_Note struct {
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	Id            int64                                       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Author        *GetMergeRequestsResponse_MergeRequest_User `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Body          string                                      `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	System        bool                                        `protobuf:"varint,4,opt,name=system,proto3" json:"system,omitempty"` // note generated by gitlab, i.e. about approval
+	Resolvable    bool                                        `protobuf:"varint,5,opt,name=resolvable,proto3" json:"resolvable,omitempty"`
+	Resolved      bool                                        `protobuf:"varint,6,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	ResolvedBy    *GetMergeRequestsResponse_MergeRequest_User `protobuf:"bytes,7,opt,name=resolvedBy,proto3" json:"resolvedBy,omitempty"`
+	CreatedAt     *timestamppb.Timestamp                      `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ResolvedAt    *timestamppb.Timestamp                      `protobuf:"bytes,9,opt,name=resolvedAt,proto3" json:"resolvedAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_User) Reset() {
-	*x = GetMergeRequestsResponse_MergeRequest_User{}
-	mi := &file_mr_v1_mr_proto_msgTypes[5]
+func (x *Discussion_Note) Reset() {
+	*x = Discussion_Note{}
+	mi := &file_mr_v1_mr_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_User) String() string {
+func (x *Discussion_Note) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMergeRequestsResponse_MergeRequest_User) ProtoMessage() {}
+func (*Discussion_Note) ProtoMessage() {}
 
-func (x *GetMergeRequestsResponse_MergeRequest_User) ProtoReflect() protoreflect.Message {
-	mi := &file_mr_v1_mr_proto_msgTypes[5]
+func (x *Discussion_Note) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,70 +3958,109 @@ func (x *GetMergeRequestsResponse_MergeRequest_User) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMergeRequestsResponse_MergeRequest_User.ProtoReflect.Descriptor instead.
-func (*GetMergeRequestsResponse_MergeRequest_User) Descriptor() ([]byte, []int) {
-	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 0}
+// Deprecated: Use Discussion_Note.ProtoReflect.Descriptor instead.
+func (*Discussion_Note) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{17, 0}
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_User) GetUsername() string {
+func (x *Discussion_Note) GetId() int64 {
 	if x != nil {
-		return x.Username
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_User) GetAvatarUrl() string {
+func (x *Discussion_Note) GetAuthor() *GetMergeRequestsResponse_MergeRequest_User {
 	if x != nil {
-		return x.AvatarUrl
+		return x.Author
+	}
+	return nil
+}
+
+func (x *Discussion_Note) GetBody() string {
+	if x != nil {
+		return x.Body
 	}
 	return ""
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_User) GetTrusted() bool {
+func (x *Discussion_Note) GetSystem() bool {
+	if x != nil {
+		return x.System
+	}
+	return false
+}
+
+func (x *Discussion_Note) GetResolvable() bool {
+	if x != nil {
+		return x.Resolvable
+	}
+	return false
+}
+
+func (x *Discussion_Note) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+func (x *Discussion_Note) GetResolvedBy() *GetMergeRequestsResponse_MergeRequest_User {
 	if x != nil {
-		return x.Trusted
+		return x.ResolvedBy
 	}
-	return false
+	return nil
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_User) GetUrl() string {
+func (x *Discussion_Note) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Url
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_User) GetIsMe() bool {
+func (x *Discussion_Note) GetResolvedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IsMe
+		return x.ResolvedAt
 	}
-	return false
+	return nil
 }
 
-type GetMergeRequestsResponse_MergeRequest_Project struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Entry is a state of merge request recorded when it changed
+type GetMergeRequestTimelineResponse_Entry struct {
+	state             protoimpl.MessageState                         `protogen:"open.v1"`
+	RecordedAt        *timestamppb.Timestamp                         `protobuf:"bytes,1,opt,name=recordedAt,proto3" json:"recordedAt,omitempty"`
+	State             string                                         `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"` // opened, merged or closed
+	Title             string                                         `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Draft             bool                                           `protobuf:"varint,4,opt,name=draft,proto3" json:"draft,omitempty"`
+	Conflict          bool                                           `protobuf:"varint,5,opt,name=conflict,proto3" json:"conflict,omitempty"`
+	PipelineStatus    string                                         `protobuf:"bytes,6,opt,name=pipelineStatus,proto3" json:"pipelineStatus,omitempty"`
+	ApprovedBy        []string                                       `protobuf:"bytes,7,rep,name=approvedBy,proto3" json:"approvedBy,omitempty"` // usernames
+	ApprovalsLeft     int32                                          `protobuf:"varint,8,opt,name=approvalsLeft,proto3" json:"approvalsLeft,omitempty"`
+	Threads           int32                                          `protobuf:"varint,9,opt,name=threads,proto3" json:"threads,omitempty"` // resolvable threads
+	UnresolvedThreads int32                                          `protobuf:"varint,10,opt,name=unresolvedThreads,proto3" json:"unresolvedThreads,omitempty"`
+	Additions         int64                                          `protobuf:"varint,11,opt,name=additions,proto3" json:"additions,omitempty"`
+	Deletions         int64                                          `protobuf:"varint,12,opt,name=deletions,proto3" json:"deletions,omitempty"`
+	Changes           []GetMergeRequestTimelineResponse_Entry_Change `protobuf:"varint,13,rep,packed,name=changes,proto3,enum=mr.v1.GetMergeRequestTimelineResponse_Entry_Change" json:"changes,omitempty"` // changes since previous entry, empty for the first one
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_Project) Reset() {
-	*x = GetMergeRequestsResponse_MergeRequest_Project{}
-	mi := &file_mr_v1_mr_proto_msgTypes[6]
+func (x *GetMergeRequestTimelineResponse_Entry) Reset() {
+	*x = GetMergeRequestTimelineResponse_Entry{}
+	mi := &file_mr_v1_mr_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_Project) String() string {
+func (x *GetMergeRequestTimelineResponse_Entry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMergeRequestsResponse_MergeRequest_Project) ProtoMessage() {}
+func (*GetMergeRequestTimelineResponse_Entry) ProtoMessage() {}
 
-func (x *GetMergeRequestsResponse_MergeRequest_Project) ProtoReflect() protoreflect.Message {
-	mi := &file_mr_v1_mr_proto_msgTypes[6]
+func (x *GetMergeRequestTimelineResponse_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,139 +4071,131 @@ func (x *GetMergeRequestsResponse_MergeRequest_Project) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMergeRequestsResponse_MergeRequest_Project.ProtoReflect.Descriptor instead.
-func (*GetMergeRequestsResponse_MergeRequest_Project) Descriptor() ([]byte, []int) {
-	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 1}
+// Deprecated: Use GetMergeRequestTimelineResponse_Entry.ProtoReflect.Descriptor instead.
+func (*GetMergeRequestTimelineResponse_Entry) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{25, 0}
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_Project) GetId() int64 {
+func (x *GetMergeRequestTimelineResponse_Entry) GetRecordedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.RecordedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_Project) GetName() string {
+func (x *GetMergeRequestTimelineResponse_Entry) GetState() string {
 	if x != nil {
-		return x.Name
+		return x.State
 	}
 	return ""
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_Project) GetUrl() string {
+func (x *GetMergeRequestTimelineResponse_Entry) GetTitle() string {
 	if x != nil {
-		return x.Url
+		return x.Title
 	}
 	return ""
 }
 
-type GetMergeRequestsResponse_MergeRequest_Status struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Conflict        bool                   `protobuf:"varint,1,opt,name=conflict,proto3" json:"conflict,omitempty"`
-	PipelineFailed  bool                   `protobuf:"varint,2,opt,name=pipelineFailed,proto3" json:"pipelineFailed,omitempty"`
-	Ready           bool                   `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
-	Outdated        bool                   `protobuf:"varint,4,opt,name=outdated,proto3" json:"outdated,omitempty"`
-	Pending         bool                   `protobuf:"varint,5,opt,name=pending,proto3" json:"pending,omitempty"`
-	EditorAvailable bool                   `protobuf:"varint,6,opt,name=editorAvailable,proto3" json:"editorAvailable,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *GetMergeRequestsResponse_MergeRequest_Status) Reset() {
-	*x = GetMergeRequestsResponse_MergeRequest_Status{}
-	mi := &file_mr_v1_mr_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetMergeRequestTimelineResponse_Entry) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_Status) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetMergeRequestTimelineResponse_Entry) GetConflict() bool {
+	if x != nil {
+		return x.Conflict
+	}
+	return false
 }
 
-func (*GetMergeRequestsResponse_MergeRequest_Status) ProtoMessage() {}
-
-func (x *GetMergeRequestsResponse_MergeRequest_Status) ProtoReflect() protoreflect.Message {
-	mi := &file_mr_v1_mr_proto_msgTypes[7]
+func (x *GetMergeRequestTimelineResponse_Entry) GetPipelineStatus() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PipelineStatus
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetMergeRequestsResponse_MergeRequest_Status.ProtoReflect.Descriptor instead.
-func (*GetMergeRequestsResponse_MergeRequest_Status) Descriptor() ([]byte, []int) {
-	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 2}
+func (x *GetMergeRequestTimelineResponse_Entry) GetApprovedBy() []string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return nil
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_Status) GetConflict() bool {
+func (x *GetMergeRequestTimelineResponse_Entry) GetApprovalsLeft() int32 {
 	if x != nil {
-		return x.Conflict
+		return x.ApprovalsLeft
 	}
-	return false
+	return 0
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_Status) GetPipelineFailed() bool {
+func (x *GetMergeRequestTimelineResponse_Entry) GetThreads() int32 {
 	if x != nil {
-		return x.PipelineFailed
+		return x.Threads
 	}
-	return false
+	return 0
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_Status) GetReady() bool {
+func (x *GetMergeRequestTimelineResponse_Entry) GetUnresolvedThreads() int32 {
 	if x != nil {
-		return x.Ready
+		return x.UnresolvedThreads
 	}
-	return false
+	return 0
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_Status) GetOutdated() bool {
+func (x *GetMergeRequestTimelineResponse_Entry) GetAdditions() int64 {
 	if x != nil {
-		return x.Outdated
+		return x.Additions
 	}
-	return false
+	return 0
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_Status) GetPending() bool {
+func (x *GetMergeRequestTimelineResponse_Entry) GetDeletions() int64 {
 	if x != nil {
-		return x.Pending
+		return x.Deletions
 	}
-	return false
+	return 0
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_Status) GetEditorAvailable() bool {
+func (x *GetMergeRequestTimelineResponse_Entry) GetChanges() []GetMergeRequestTimelineResponse_Entry_Change {
 	if x != nil {
-		return x.EditorAvailable
+		return x.Changes
 	}
-	return false
+	return nil
 }
 
-type GetMergeRequestsResponse_MergeRequest_Comments struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	UnresolvedCount int32                  `protobuf:"varint,1,opt,name=unresolvedCount,proto3" json:"unresolvedCount,omitempty"`
-	ResolvedCount   int32                  `protobuf:"varint,2,opt,name=resolvedCount,proto3" json:"resolvedCount,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetAnalyticsResponse_Metrics struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Opened int32                  `protobuf:"varint,1,opt,name=opened,proto3" json:"opened,omitempty"` // merge requests created within window
+	Merged int32                  `protobuf:"varint,2,opt,name=merged,proto3" json:"merged,omitempty"` // merge requests merged within window
+	// medianTimeToFirstReview is measured for merge requests first reviewed within window, not set when there are none
+	MedianTimeToFirstReview *durationpb.Duration `protobuf:"bytes,3,opt,name=medianTimeToFirstReview,proto3" json:"medianTimeToFirstReview,omitempty"`
+	// medianTimeToMerge is measured for merge requests merged within window, not set when there are none
+	MedianTimeToMerge *durationpb.Duration                 `protobuf:"bytes,4,opt,name=medianTimeToMerge,proto3" json:"medianTimeToMerge,omitempty"`
+	ReviewsGiven      int32                                `protobuf:"varint,5,opt,name=reviewsGiven,proto3" json:"reviewsGiven,omitempty"` // merge requests reviewed by person within window, set for people only
+	Sizes             []*GetAnalyticsResponse_Metrics_Size `protobuf:"bytes,6,rep,name=sizes,proto3" json:"sizes,omitempty"`                // sizes of merge requests created within window, from the smallest
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_Comments) Reset() {
-	*x = GetMergeRequestsResponse_MergeRequest_Comments{}
-	mi := &file_mr_v1_mr_proto_msgTypes[8]
+func (x *GetAnalyticsResponse_Metrics) Reset() {
+	*x = GetAnalyticsResponse_Metrics{}
+	mi := &file_mr_v1_mr_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_Comments) String() string {
+func (x *GetAnalyticsResponse_Metrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMergeRequestsResponse_MergeRequest_Comments) ProtoMessage() {}
+func (*GetAnalyticsResponse_Metrics) ProtoMessage() {}
 
-func (x *GetMergeRequestsResponse_MergeRequest_Comments) ProtoReflect() protoreflect.Message {
-	mi := &file_mr_v1_mr_proto_msgTypes[8]
+func (x *GetAnalyticsResponse_Metrics) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,101 +4206,76 @@ func (x *GetMergeRequestsResponse_MergeRequest_Comments) ProtoReflect() protoref
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMergeRequestsResponse_MergeRequest_Comments.ProtoReflect.Descriptor instead.
-func (*GetMergeRequestsResponse_MergeRequest_Comments) Descriptor() ([]byte, []int) {
-	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 3}
+// Deprecated: Use GetAnalyticsResponse_Metrics.ProtoReflect.Descriptor instead.
+func (*GetAnalyticsResponse_Metrics) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{27, 0}
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_Comments) GetUnresolvedCount() int32 {
+func (x *GetAnalyticsResponse_Metrics) GetOpened() int32 {
 	if x != nil {
-		return x.UnresolvedCount
+		return x.Opened
 	}
 	return 0
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_Comments) GetResolvedCount() int32 {
+func (x *GetAnalyticsResponse_Metrics) GetMerged() int32 {
 	if x != nil {
-		return x.ResolvedCount
+		return x.Merged
 	}
 	return 0
 }
 
-type GetMergeRequestsResponse_MergeRequest_Issue struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetMergeRequestsResponse_MergeRequest_Issue) Reset() {
-	*x = GetMergeRequestsResponse_MergeRequest_Issue{}
-	mi := &file_mr_v1_mr_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetMergeRequestsResponse_MergeRequest_Issue) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetMergeRequestsResponse_MergeRequest_Issue) ProtoMessage() {}
-
-func (x *GetMergeRequestsResponse_MergeRequest_Issue) ProtoReflect() protoreflect.Message {
-	mi := &file_mr_v1_mr_proto_msgTypes[9]
+func (x *GetAnalyticsResponse_Metrics) GetMedianTimeToFirstReview() *durationpb.Duration {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MedianTimeToFirstReview
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetMergeRequestsResponse_MergeRequest_Issue.ProtoReflect.Descriptor instead.
-func (*GetMergeRequestsResponse_MergeRequest_Issue) Descriptor() ([]byte, []int) {
-	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 4}
+func (x *GetAnalyticsResponse_Metrics) GetMedianTimeToMerge() *durationpb.Duration {
+	if x != nil {
+		return x.MedianTimeToMerge
+	}
+	return nil
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_Issue) GetKey() string {
+func (x *GetAnalyticsResponse_Metrics) GetReviewsGiven() int32 {
 	if x != nil {
-		return x.Key
+		return x.ReviewsGiven
 	}
-	return ""
+	return 0
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_Issue) GetUrl() string {
+func (x *GetAnalyticsResponse_Metrics) GetSizes() []*GetAnalyticsResponse_Metrics_Size {
 	if x != nil {
-		return x.Url
+		return x.Sizes
 	}
-	return ""
+	return nil
 }
 
-type GetMergeRequestsResponse_MergeRequest_DiffStatsSummary struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Additions     int64                  `protobuf:"varint,1,opt,name=additions,proto3" json:"additions,omitempty"`
-	Deletions     int64                  `protobuf:"varint,2,opt,name=deletions,proto3" json:"deletions,omitempty"`
-	FileCount     int64                  `protobuf:"varint,3,opt,name=fileCount,proto3" json:"fileCount,omitempty"`
+type GetAnalyticsResponse_NamedMetrics struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Name          string                        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Metrics       *GetAnalyticsResponse_Metrics `protobuf:"bytes,2,opt,name=metrics,proto3" json:"metrics,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_DiffStatsSummary) Reset() {
-	*x = GetMergeRequestsResponse_MergeRequest_DiffStatsSummary{}
-	mi := &file_mr_v1_mr_proto_msgTypes[10]
+func (x *GetAnalyticsResponse_NamedMetrics) Reset() {
+	*x = GetAnalyticsResponse_NamedMetrics{}
+	mi := &file_mr_v1_mr_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_DiffStatsSummary) String() string {
+func (x *GetAnalyticsResponse_NamedMetrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMergeRequestsResponse_MergeRequest_DiffStatsSummary) ProtoMessage() {}
+func (*GetAnalyticsResponse_NamedMetrics) ProtoMessage() {}
 
-func (x *GetMergeRequestsResponse_MergeRequest_DiffStatsSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_mr_v1_mr_proto_msgTypes[10]
+func (x *GetAnalyticsResponse_NamedMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -729,57 +4286,48 @@ func (x *GetMergeRequestsResponse_MergeRequest_DiffStatsSummary) ProtoReflect()
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMergeRequestsResponse_MergeRequest_DiffStatsSummary.ProtoReflect.Descriptor instead.
-func (*GetMergeRequestsResponse_MergeRequest_DiffStatsSummary) Descriptor() ([]byte, []int) {
-	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 0, 5}
-}
-
-func (x *GetMergeRequestsResponse_MergeRequest_DiffStatsSummary) GetAdditions() int64 {
-	if x != nil {
-		return x.Additions
-	}
-	return 0
+// Deprecated: Use GetAnalyticsResponse_NamedMetrics.ProtoReflect.Descriptor instead.
+func (*GetAnalyticsResponse_NamedMetrics) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{27, 1}
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_DiffStatsSummary) GetDeletions() int64 {
+func (x *GetAnalyticsResponse_NamedMetrics) GetName() string {
 	if x != nil {
-		return x.Deletions
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *GetMergeRequestsResponse_MergeRequest_DiffStatsSummary) GetFileCount() int64 {
+func (x *GetAnalyticsResponse_NamedMetrics) GetMetrics() *GetAnalyticsResponse_Metrics {
 	if x != nil {
-		return x.FileCount
+		return x.Metrics
 	}
-	return 0
+	return nil
 }
 
-type GetMergeRequestsResponse_Group_Summary struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Total          int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Visible        int32                  `protobuf:"varint,2,opt,name=visible,proto3" json:"visible,omitempty"`
-	Overdue        int32                  `protobuf:"varint,3,opt,name=overdue,proto3" json:"overdue,omitempty"`
-	OverdueVisible int32                  `protobuf:"varint,4,opt,name=overdueVisible,proto3" json:"overdueVisible,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetAnalyticsResponse_Metrics_Size struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"` // xs (< 10 lines), s (< 50), m (< 250), l (< 1000), xl
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMergeRequestsResponse_Group_Summary) Reset() {
-	*x = GetMergeRequestsResponse_Group_Summary{}
-	mi := &file_mr_v1_mr_proto_msgTypes[11]
+func (x *GetAnalyticsResponse_Metrics_Size) Reset() {
+	*x = GetAnalyticsResponse_Metrics_Size{}
+	mi := &file_mr_v1_mr_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMergeRequestsResponse_Group_Summary) String() string {
+func (x *GetAnalyticsResponse_Metrics_Size) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMergeRequestsResponse_Group_Summary) ProtoMessage() {}
+func (*GetAnalyticsResponse_Metrics_Size) ProtoMessage() {}
 
-func (x *GetMergeRequestsResponse_Group_Summary) ProtoReflect() protoreflect.Message {
-	mi := &file_mr_v1_mr_proto_msgTypes[11]
+func (x *GetAnalyticsResponse_Metrics_Size) ProtoReflect() protoreflect.Message {
+	mi := &file_mr_v1_mr_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,35 +4338,21 @@ func (x *GetMergeRequestsResponse_Group_Summary) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMergeRequestsResponse_Group_Summary.ProtoReflect.Descriptor instead.
-func (*GetMergeRequestsResponse_Group_Summary) Descriptor() ([]byte, []int) {
-	return file_mr_v1_mr_proto_rawDescGZIP(), []int{1, 1, 0}
-}
-
-func (x *GetMergeRequestsResponse_Group_Summary) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *GetMergeRequestsResponse_Group_Summary) GetVisible() int32 {
-	if x != nil {
-		return x.Visible
-	}
-	return 0
+// Deprecated: Use GetAnalyticsResponse_Metrics_Size.ProtoReflect.Descriptor instead.
+func (*GetAnalyticsResponse_Metrics_Size) Descriptor() ([]byte, []int) {
+	return file_mr_v1_mr_proto_rawDescGZIP(), []int{27, 0, 0}
 }
 
-func (x *GetMergeRequestsResponse_Group_Summary) GetOverdue() int32 {
+func (x *GetAnalyticsResponse_Metrics_Size) GetBucket() string {
 	if x != nil {
-		return x.Overdue
+		return x.Bucket
 	}
-	return 0
+	return ""
 }
 
-func (x *GetMergeRequestsResponse_Group_Summary) GetOverdueVisible() int32 {
+func (x *GetAnalyticsResponse_Metrics_Size) GetCount() int32 {
 	if x != nil {
-		return x.OverdueVisible
+		return x.Count
 	}
 	return 0
 }
@@ -827,17 +4361,52 @@ var File_mr_v1_mr_proto protoreflect.FileDescriptor
 
 const file_mr_v1_mr_proto_rawDesc = "" +
 	"\n" +
-	"\x0emr/v1/mr.proto\x12\x05mr.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x89\x02\n" +
+	"\x0emr/v1/mr.proto\x12\x05mr.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8a\t\n" +
 	"\x17GetMergeRequestsRequest\x12=\n" +
-	"\x06filter\x18\x01 \x01(\v2%.mr.v1.GetMergeRequestsRequest.FilterR\x06filter\x1a\xae\x01\n" +
+	"\x06filter\x18\x01 \x01(\v2%.mr.v1.GetMergeRequestsRequest.FilterR\x06filter\x12\"\n" +
+	"\fforceRefresh\x18\x02 \x01(\bR\fforceRefresh\x127\n" +
+	"\x04sort\x18\x03 \x01(\v2#.mr.v1.GetMergeRequestsRequest.SortR\x04sort\x1a\xfb\x05\n" +
 	"\x06Filter\x12*\n" +
 	"\x10skipApprovedByMe\x18\x01 \x01(\bR\x10skipApprovedByMe\x12\"\n" +
 	"\fshowOnlyMine\x18\x02 \x01(\bR\fshowOnlyMine\x12*\n" +
 	"\x10butStillShowMine\x18\x03 \x01(\bR\x10butStillShowMine\x12(\n" +
-	"\x0fdoNotShowDrafts\x18\x04 \x01(\bR\x0fdoNotShowDrafts\"\xe7\r\n" +
-	"\x18GetMergeRequestsResponse\x12=\n" +
-	"\x06groups\x18\x01 \x03(\v2%.mr.v1.GetMergeRequestsResponse.GroupR\x06groups\x1a\xd3\n" +
+	"\x0fdoNotShowDrafts\x18\x04 \x01(\bR\x0fdoNotShowDrafts\x12\x18\n" +
+	"\aauthors\x18\x05 \x03(\tR\aauthors\x12\x1c\n" +
+	"\treviewers\x18\x06 \x03(\tR\treviewers\x12\x16\n" +
+	"\x06labels\x18\a \x03(\tR\x06labels\x12&\n" +
+	"\x0etargetBranches\x18\b \x03(\tR\x0etargetBranches\x12*\n" +
+	"\x10pipelineStatuses\x18\t \x03(\tR\x10pipelineStatuses\x12\x1f\n" +
+	"\bconflict\x18\n" +
+	" \x01(\bH\x00R\bconflict\x88\x01\x01\x12)\n" +
+	"\rhasUnresolved\x18\v \x01(\bH\x01R\rhasUnresolved\x88\x01\x01\x121\n" +
+	"\x06minAge\x18\f \x01(\v2\x19.google.protobuf.DurationR\x06minAge\x121\n" +
+	"\x06maxAge\x18\r \x01(\v2\x19.google.protobuf.DurationR\x06maxAge\x12 \n" +
+	"\vminDiffSize\x18\x0e \x01(\x03R\vminDiffSize\x12 \n" +
+	"\vmaxDiffSize\x18\x0f \x01(\x03R\vmaxDiffSize\x12\x12\n" +
+	"\x04text\x18\x10 \x01(\tR\x04text\x12\x17\n" +
+	"\x04seen\x18\x11 \x01(\bH\x02R\x04seen\x88\x01\x01\x12\x1b\n" +
+	"\x06pinned\x18\x12 \x01(\bH\x03R\x06pinned\x88\x01\x01\x12 \n" +
+	"\vshowSnoozed\x18\x13 \x01(\bR\vshowSnoozed\x12\x12\n" +
+	"\x04tags\x18\x14 \x03(\tR\x04tagsB\v\n" +
+	"\t_conflictB\x10\n" +
+	"\x0e_hasUnresolvedB\a\n" +
+	"\x05_seenB\t\n" +
+	"\a_pinned\x1a\xd4\x01\n" +
+	"\x04Sort\x126\n" +
+	"\x02by\x18\x01 \x01(\x0e2&.mr.v1.GetMergeRequestsRequest.Sort.ByR\x02by\x12\x18\n" +
+	"\areverse\x18\x02 \x01(\bR\areverse\"z\n" +
+	"\x02By\x12\n" +
 	"\n" +
+	"\x06BY_AGE\x10\x00\x12\x14\n" +
+	"\x10BY_LAST_ACTIVITY\x10\x01\x12\x10\n" +
+	"\fBY_DIFF_SIZE\x10\x02\x12\x11\n" +
+	"\rBY_UNRESOLVED\x10\x03\x12\x15\n" +
+	"\x11BY_APPROVALS_LEFT\x10\x04\x12\x16\n" +
+	"\x12BY_REVIEW_PRIORITY\x10\x05\"\xbe,\n" +
+	"\x18GetMergeRequestsResponse\x12=\n" +
+	"\x06groups\x18\x01 \x03(\v2%.mr.v1.GetMergeRequestsResponse.GroupR\x06groups\x128\n" +
+	"\tupdatedAt\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12.\n" +
+	"\x12snapshotAgeSeconds\x18\x03 \x01(\x03R\x12snapshotAgeSeconds\x1a\xa7&\n" +
 	"\fMergeRequest\x12N\n" +
 	"\aproject\x18\x01 \x01(\v24.mr.v1.GetMergeRequestsResponse.MergeRequest.ProjectR\aproject\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12 \n" +
@@ -853,7 +4422,27 @@ const file_mr_v1_mr_proto_rawDesc = "" +
 	"\x03iid\x18\n" +
 	" \x01(\x03R\x03iid\x12J\n" +
 	"\x06issues\x18\v \x03(\v22.mr.v1.GetMergeRequestsResponse.MergeRequest.IssueR\x06issues\x12i\n" +
-	"\x10diffStatsSummary\x18\f \x01(\v2=.mr.v1.GetMergeRequestsResponse.MergeRequest.DiffStatsSummaryR\x10diffStatsSummary\x1a\x80\x01\n" +
+	"\x10diffStatsSummary\x18\f \x01(\v2=.mr.v1.GetMergeRequestsResponse.MergeRequest.DiffStatsSummaryR\x10diffStatsSummary\x12\x1a\n" +
+	"\bwarnings\x18\r \x03(\tR\bwarnings\x12T\n" +
+	"\tapprovals\x18\x0e \x01(\v26.mr.v1.GetMergeRequestsResponse.MergeRequest.ApprovalsR\tapprovals\x12]\n" +
+	"\x0eapprovalsGiven\x18\x0f \x03(\v25.mr.v1.GetMergeRequestsResponse.MergeRequest.ApprovalR\x0eapprovalsGiven\x12`\n" +
+	"\rreviewMetrics\x18\x10 \x01(\v2:.mr.v1.GetMergeRequestsResponse.MergeRequest.ReviewMetricsR\rreviewMetrics\x12Q\n" +
+	"\bpipeline\x18\x11 \x01(\v25.mr.v1.GetMergeRequestsResponse.MergeRequest.PipelineR\bpipeline\x12V\n" +
+	"\n" +
+	"highlights\x18\x12 \x03(\v26.mr.v1.GetMergeRequestsResponse.MergeRequest.HighlightR\n" +
+	"highlights\x12&\n" +
+	"\x0ereviewPriority\x18\x13 \x01(\x01R\x0ereviewPriority\x12J\n" +
+	"\x06labels\x18\x14 \x03(\v22.mr.v1.GetMergeRequestsResponse.MergeRequest.LabelR\x06labels\x12T\n" +
+	"\tmilestone\x18\x15 \x01(\v26.mr.v1.GetMergeRequestsResponse.MergeRequest.MilestoneR\tmilestone\x12O\n" +
+	"\treviewers\x18\x16 \x03(\v21.mr.v1.GetMergeRequestsResponse.MergeRequest.UserR\treviewers\x12O\n" +
+	"\tassignees\x18\x17 \x03(\v21.mr.v1.GetMergeRequestsResponse.MergeRequest.UserR\tassignees\x12\"\n" +
+	"\fsourceBranch\x18\x18 \x01(\tR\fsourceBranch\x12\"\n" +
+	"\ftargetBranch\x18\x19 \x01(\tR\ftargetBranch\x12\x14\n" +
+	"\x05draft\x18\x1a \x01(\bR\x05draft\x12o\n" +
+	"\x12suggestedReviewers\x18\x1b \x03(\v2?.mr.v1.GetMergeRequestsResponse.MergeRequest.ReviewerSuggestionR\x12suggestedReviewers\x12H\n" +
+	"\x05marks\x18\x1c \x01(\v22.mr.v1.GetMergeRequestsResponse.MergeRequest.MarksR\x05marks\x12 \n" +
+	"\vprivateNote\x18\x1d \x01(\tR\vprivateNote\x12\x12\n" +
+	"\x04tags\x18\x1e \x03(\tR\x04tags\x1a\x80\x01\n" +
 	"\x04User\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1c\n" +
 	"\tavatarUrl\x18\x02 \x01(\tR\tavatarUrl\x12\x18\n" +
@@ -863,14 +4452,24 @@ const file_mr_v1_mr_proto_rawDesc = "" +
 	"\aProject\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
-	"\x03url\x18\x03 \x01(\tR\x03url\x1a\xc2\x01\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x1a\xdd\x04\n" +
 	"\x06Status\x12\x1a\n" +
 	"\bconflict\x18\x01 \x01(\bR\bconflict\x12&\n" +
 	"\x0epipelineFailed\x18\x02 \x01(\bR\x0epipelineFailed\x12\x14\n" +
 	"\x05ready\x18\x03 \x01(\bR\x05ready\x12\x1a\n" +
 	"\boutdated\x18\x04 \x01(\bR\boutdated\x12\x18\n" +
 	"\apending\x18\x05 \x01(\bR\apending\x12(\n" +
-	"\x0feditorAvailable\x18\x06 \x01(\bR\x0feditorAvailable\x1aZ\n" +
+	"\x0feditorAvailable\x18\x06 \x01(\bR\x0feditorAvailable\x12f\n" +
+	"\rslaViolations\x18\a \x03(\v2@.mr.v1.GetMergeRequestsResponse.MergeRequest.Status.SLAViolationR\rslaViolations\x1a\xb0\x02\n" +
+	"\fSLAViolation\x12Y\n" +
+	"\x04type\x18\x01 \x01(\x0e2E.mr.v1.GetMergeRequestsResponse.MergeRequest.Status.SLAViolation.TypeR\x04type\x12/\n" +
+	"\x05limit\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x05limit\x121\n" +
+	"\x06actual\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x06actual\"a\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fTYPE_MAX_AGE\x10\x01\x12\x11\n" +
+	"\rTYPE_MAX_IDLE\x10\x02\x12\x1e\n" +
+	"\x1aTYPE_MAX_FIRST_REVIEW_WAIT\x10\x03\x1aZ\n" +
 	"\bComments\x12(\n" +
 	"\x0funresolvedCount\x18\x01 \x01(\x05R\x0funresolvedCount\x12$\n" +
 	"\rresolvedCount\x18\x02 \x01(\x05R\rresolvedCount\x1a+\n" +
@@ -880,18 +4479,291 @@ const file_mr_v1_mr_proto_rawDesc = "" +
 	"\x10DiffStatsSummary\x12\x1c\n" +
 	"\tadditions\x18\x01 \x01(\x03R\tadditions\x12\x1c\n" +
 	"\tdeletions\x18\x02 \x01(\x03R\tdeletions\x12\x1c\n" +
-	"\tfileCount\x18\x03 \x01(\x03R\tfileCount\x1a\xb5\x02\n" +
+	"\tfileCount\x18\x03 \x01(\x03R\tfileCount\x1a\xb4\x02\n" +
+	"\fApprovalRule\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12,\n" +
+	"\x11approvalsRequired\x18\x03 \x01(\x05R\x11approvalsRequired\x12\x1a\n" +
+	"\bapproved\x18\x04 \x01(\bR\bapproved\x12Q\n" +
+	"\n" +
+	"approvedBy\x18\x05 \x03(\v21.mr.v1.GetMergeRequestsResponse.MergeRequest.UserR\n" +
+	"approvedBy\x12_\n" +
+	"\x11eligibleApprovers\x18\x06 \x03(\v21.mr.v1.GetMergeRequestsResponse.MergeRequest.UserR\x11eligibleApprovers\x1a\xb4\x01\n" +
+	"\tApprovals\x12\x1a\n" +
+	"\brequired\x18\x01 \x01(\x05R\brequired\x12\x12\n" +
+	"\x04left\x18\x02 \x01(\x05R\x04left\x12&\n" +
+	"\x0eapprovableByMe\x18\x03 \x01(\bR\x0eapprovableByMe\x12O\n" +
+	"\x05rules\x18\x04 \x03(\v29.mr.v1.GetMergeRequestsResponse.MergeRequest.ApprovalRuleR\x05rules\x1a\x8d\x01\n" +
+	"\bApproval\x12E\n" +
+	"\x04user\x18\x01 \x01(\v21.mr.v1.GetMergeRequestsResponse.MergeRequest.UserR\x04user\x12:\n" +
+	"\n" +
+	"approvedAt\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"approvedAt\x1a\xc3\x03\n" +
+	"\bPipeline\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x125\n" +
+	"\bduration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12Y\n" +
+	"\n" +
+	"failedJobs\x18\x05 \x03(\v29.mr.v1.GetMergeRequestsResponse.MergeRequest.Pipeline.JobR\n" +
+	"failedJobs\x1a\xea\x01\n" +
+	"\x03Job\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05stage\x18\x03 \x01(\tR\x05stage\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\x125\n" +
+	"\bduration\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\"\n" +
+	"\fallowFailure\x18\a \x01(\bR\fallowFailure\x12$\n" +
+	"\rfailureReason\x18\b \x01(\tR\rfailureReason\x1a3\n" +
+	"\x05Label\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x14\n" +
+	"\x05color\x18\x02 \x01(\tR\x05color\x1ai\n" +
+	"\tMilestone\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x124\n" +
+	"\adueDate\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x1a\xd7\x01\n" +
+	"\x12ReviewerSuggestion\x12E\n" +
+	"\x04user\x18\x01 \x01(\v21.mr.v1.GetMergeRequestsResponse.MergeRequest.UserR\x04user\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12 \n" +
+	"\vopenReviews\x18\x03 \x01(\x05R\vopenReviews\x12$\n" +
+	"\rrecentReviews\x18\x04 \x01(\x05R\rrecentReviews\x12\x1c\n" +
+	"\tcodeOwner\x18\x05 \x01(\bR\tcodeOwner\x1a\x8d\x01\n" +
+	"\x05Marks\x12\x12\n" +
+	"\x04seen\x18\x01 \x01(\bR\x04seen\x12\x16\n" +
+	"\x06pinned\x18\x02 \x01(\bR\x06pinned\x12\x18\n" +
+	"\asnoozed\x18\x03 \x01(\bR\asnoozed\x12>\n" +
+	"\fsnoozedUntil\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fsnoozedUntil\x1aQ\n" +
+	"\tHighlight\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x02 \x01(\tR\x05color\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\x1a\xfc\x01\n" +
+	"\rReviewMetrics\x12I\n" +
+	"\x12timeToFirstComment\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x12timeToFirstComment\x12K\n" +
+	"\x13timeToFirstApproval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x13timeToFirstApproval\x12S\n" +
+	"\x17timeToRequiredApprovals\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x17timeToRequiredApprovals\x1a\xce\x04\n" +
 	"\x05Group\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12R\n" +
 	"\rmergeRequests\x18\x02 \x03(\v2,.mr.v1.GetMergeRequestsResponse.MergeRequestR\rmergeRequests\x12G\n" +
-	"\asummary\x18\x03 \x01(\v2-.mr.v1.GetMergeRequestsResponse.Group.SummaryR\asummary\x1a{\n" +
+	"\asummary\x18\x03 \x01(\v2-.mr.v1.GetMergeRequestsResponse.Group.SummaryR\asummary\x12J\n" +
+	"\x06errors\x18\x04 \x03(\v22.mr.v1.GetMergeRequestsResponse.Group.ProjectErrorR\x06errors\x1a{\n" +
 	"\aSummary\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x18\n" +
 	"\avisible\x18\x02 \x01(\x05R\avisible\x12\x18\n" +
 	"\aoverdue\x18\x03 \x01(\x05R\aoverdue\x12&\n" +
-	"\x0eoverdueVisible\x18\x04 \x01(\x05R\x0eoverdueVisible2\x88\x01\n" +
+	"\x0eoverdueVisible\x18\x04 \x01(\x05R\x0eoverdueVisible\x1a\xca\x01\n" +
+	"\fProjectError\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12 \n" +
+	"\vprojectName\x18\x02 \x01(\tR\vprojectName\x12\x1e\n" +
+	"\n" +
+	"httpStatus\x18\x03 \x01(\x05R\n" +
+	"httpStatus\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12@\n" +
+	"\rlastSuccessAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rlastSuccessAt\"\x1b\n" +
+	"\x19WatchMergeRequestsRequest\"\xde\x04\n" +
+	"\x11MergeRequestEvent\x121\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1d.mr.v1.MergeRequestEvent.TypeR\x04type\x12:\n" +
+	"\n" +
+	"occurredAt\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x1c\n" +
+	"\tgroupName\x18\x03 \x01(\tR\tgroupName\x12P\n" +
+	"\fmergeRequest\x18\x04 \x01(\v2,.mr.v1.GetMergeRequestsResponse.MergeRequestR\fmergeRequest\x12E\n" +
+	"\x04user\x18\x05 \x01(\v21.mr.v1.GetMergeRequestsResponse.MergeRequest.UserR\x04user\x12&\n" +
+	"\x0epipelineStatus\x18\x06 \x01(\tR\x0epipelineStatus\x126\n" +
+	"\x16previousPipelineStatus\x18\a \x01(\tR\x16previousPipelineStatus\"\xc2\x01\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vTYPE_OPENED\x10\x01\x12\x0f\n" +
+	"\vTYPE_CLOSED\x10\x02\x12\x0f\n" +
+	"\vTYPE_MERGED\x10\x03\x12\x11\n" +
+	"\rTYPE_APPROVED\x10\x04\x12 \n" +
+	"\x1cTYPE_PIPELINE_STATUS_CHANGED\x10\x05\x12 \n" +
+	"\x1cTYPE_UNRESOLVED_THREAD_ADDED\x10\x06\x12\x1a\n" +
+	"\x16TYPE_CONFLICT_APPEARED\x10\a\"L\n" +
+	"\x1aApproveMergeRequestRequest\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03iid\x18\x02 \x01(\x03R\x03iid\"N\n" +
+	"\x1cUnapproveMergeRequestRequest\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03iid\x18\x02 \x01(\x03R\x03iid\"\xc6\x01\n" +
+	"\x18MergeMergeRequestRequest\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03iid\x18\x02 \x01(\x03R\x03iid\x12\x16\n" +
+	"\x06squash\x18\x03 \x01(\bR\x06squash\x12.\n" +
+	"\x12removeSourceBranch\x18\x04 \x01(\bR\x12removeSourceBranch\x122\n" +
+	"\x14whenPipelineSucceeds\x18\x05 \x01(\bR\x14whenPipelineSucceeds\"K\n" +
+	"\x19RebaseMergeRequestRequest\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03iid\x18\x02 \x01(\x03R\x03iid\"f\n" +
+	"\x14RetryPipelineRequest\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03iid\x18\x02 \x01(\x03R\x03iid\x12\x1e\n" +
+	"\n" +
+	"pipelineId\x18\x03 \x01(\x03R\n" +
+	"pipelineId\"W\n" +
+	"\x0fRetryJobRequest\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03iid\x18\x02 \x01(\x03R\x03iid\x12\x14\n" +
+	"\x05jobId\x18\x03 \x01(\x03R\x05jobId\"c\n" +
+	"\x15AssignReviewerRequest\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03iid\x18\x02 \x01(\x03R\x03iid\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\"a\n" +
+	"\x1bMarkMergeRequestSeenRequest\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03iid\x18\x02 \x01(\x03R\x03iid\x12\x12\n" +
+	"\x04seen\x18\x03 \x01(\bR\x04seen\"\x9f\x01\n" +
+	"\x19SnoozeMergeRequestRequest\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03iid\x18\x02 \x01(\x03R\x03iid\x120\n" +
+	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12 \n" +
+	"\vuntilChange\x18\x04 \x01(\bR\vuntilChange\"`\n" +
+	"\x16PinMergeRequestRequest\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03iid\x18\x02 \x01(\x03R\x03iid\x12\x16\n" +
+	"\x06pinned\x18\x03 \x01(\bR\x06pinned\"[\n" +
+	"\x15SetPrivateNoteRequest\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03iid\x18\x02 \x01(\x03R\x03iid\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"T\n" +
+	"\x0eSetTagsRequest\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03iid\x18\x02 \x01(\x03R\x03iid\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\"n\n" +
+	"\x1aMergeRequestActionResponse\x12P\n" +
+	"\fmergeRequest\x18\x01 \x01(\v2,.mr.v1.GetMergeRequestsResponse.MergeRequestR\fmergeRequest\"\x9b\x04\n" +
+	"\n" +
+	"Discussion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
+	"\n" +
+	"resolvable\x18\x02 \x01(\bR\n" +
+	"resolvable\x12\x1a\n" +
+	"\bresolved\x18\x03 \x01(\bR\bresolved\x12,\n" +
+	"\x05notes\x18\x04 \x03(\v2\x16.mr.v1.Discussion.NoteR\x05notes\x1a\x92\x03\n" +
+	"\x04Note\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12I\n" +
+	"\x06author\x18\x02 \x01(\v21.mr.v1.GetMergeRequestsResponse.MergeRequest.UserR\x06author\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x16\n" +
+	"\x06system\x18\x04 \x01(\bR\x06system\x12\x1e\n" +
+	"\n" +
+	"resolvable\x18\x05 \x01(\bR\n" +
+	"resolvable\x12\x1a\n" +
+	"\bresolved\x18\x06 \x01(\bR\bresolved\x12Q\n" +
+	"\n" +
+	"resolvedBy\x18\a \x01(\v21.mr.v1.GetMergeRequestsResponse.MergeRequest.UserR\n" +
+	"resolvedBy\x128\n" +
+	"\tcreatedAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12:\n" +
+	"\n" +
+	"resolvedAt\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\"q\n" +
+	"\x15GetDiscussionsRequest\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03iid\x18\x02 \x01(\x03R\x03iid\x12(\n" +
+	"\x0fskipSystemNotes\x18\x03 \x01(\bR\x0fskipSystemNotes\"M\n" +
+	"\x16GetDiscussionsResponse\x123\n" +
+	"\vdiscussions\x18\x01 \x03(\v2\x11.mr.v1.DiscussionR\vdiscussions\"W\n" +
+	"\x11AddCommentRequest\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03iid\x18\x02 \x01(\x03R\x03iid\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\"\x82\x01\n" +
+	"\x18ReplyToDiscussionRequest\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03iid\x18\x02 \x01(\x03R\x03iid\x12\"\n" +
+	"\fdiscussionId\x18\x03 \x01(\tR\fdiscussionId\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\"\x8a\x01\n" +
+	"\x18ResolveDiscussionRequest\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03iid\x18\x02 \x01(\x03R\x03iid\x12\"\n" +
+	"\fdiscussionId\x18\x03 \x01(\tR\fdiscussionId\x12\x1a\n" +
+	"\bresolved\x18\x04 \x01(\bR\bresolved\"G\n" +
+	"\x12DiscussionResponse\x121\n" +
+	"\n" +
+	"discussion\x18\x01 \x01(\v2\x11.mr.v1.DiscussionR\n" +
+	"discussion\"P\n" +
+	"\x1eGetMergeRequestTimelineRequest\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03iid\x18\x02 \x01(\x03R\x03iid\"\xc2\a\n" +
+	"\x1fGetMergeRequestTimelineResponse\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\x03R\tprojectId\x12\x10\n" +
+	"\x03iid\x18\x02 \x01(\x03R\x03iid\x12 \n" +
+	"\vprojectName\x18\x03 \x01(\tR\vprojectName\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x16\n" +
+	"\x06author\x18\x05 \x01(\tR\x06author\x128\n" +
+	"\tcreatedAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12F\n" +
+	"\aentries\x18\a \x03(\v2,.mr.v1.GetMergeRequestTimelineResponse.EntryR\aentries\x1a\xa0\x05\n" +
+	"\x05Entry\x12:\n" +
+	"\n" +
+	"recordedAt\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"recordedAt\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x14\n" +
+	"\x05draft\x18\x04 \x01(\bR\x05draft\x12\x1a\n" +
+	"\bconflict\x18\x05 \x01(\bR\bconflict\x12&\n" +
+	"\x0epipelineStatus\x18\x06 \x01(\tR\x0epipelineStatus\x12\x1e\n" +
+	"\n" +
+	"approvedBy\x18\a \x03(\tR\n" +
+	"approvedBy\x12$\n" +
+	"\rapprovalsLeft\x18\b \x01(\x05R\rapprovalsLeft\x12\x18\n" +
+	"\athreads\x18\t \x01(\x05R\athreads\x12,\n" +
+	"\x11unresolvedThreads\x18\n" +
+	" \x01(\x05R\x11unresolvedThreads\x12\x1c\n" +
+	"\tadditions\x18\v \x01(\x03R\tadditions\x12\x1c\n" +
+	"\tdeletions\x18\f \x01(\x03R\tdeletions\x12M\n" +
+	"\achanges\x18\r \x03(\x0e23.mr.v1.GetMergeRequestTimelineResponse.Entry.ChangeR\achanges\"\xbb\x01\n" +
+	"\x06Change\x12\x16\n" +
+	"\x12CHANGE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fCHANGE_STATE\x10\x01\x12\x10\n" +
+	"\fCHANGE_TITLE\x10\x02\x12\x10\n" +
+	"\fCHANGE_DRAFT\x10\x03\x12\x13\n" +
+	"\x0fCHANGE_CONFLICT\x10\x04\x12\x13\n" +
+	"\x0fCHANGE_PIPELINE\x10\x05\x12\x14\n" +
+	"\x10CHANGE_APPROVALS\x10\x06\x12\x12\n" +
+	"\x0eCHANGE_THREADS\x10\a\x12\x0f\n" +
+	"\vCHANGE_DIFF\x10\b\"q\n" +
+	"\x13GetAnalyticsRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\x88\x06\n" +
+	"\x14GetAnalyticsResponse\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x129\n" +
+	"\x05total\x18\x03 \x01(\v2#.mr.v1.GetAnalyticsResponse.MetricsR\x05total\x12@\n" +
+	"\x06groups\x18\x04 \x03(\v2(.mr.v1.GetAnalyticsResponse.NamedMetricsR\x06groups\x12@\n" +
+	"\x06people\x18\x05 \x03(\v2(.mr.v1.GetAnalyticsResponse.NamedMetricsR\x06people\x1a\xf1\x02\n" +
+	"\aMetrics\x12\x16\n" +
+	"\x06opened\x18\x01 \x01(\x05R\x06opened\x12\x16\n" +
+	"\x06merged\x18\x02 \x01(\x05R\x06merged\x12S\n" +
+	"\x17medianTimeToFirstReview\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x17medianTimeToFirstReview\x12G\n" +
+	"\x11medianTimeToMerge\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x11medianTimeToMerge\x12\"\n" +
+	"\freviewsGiven\x18\x05 \x01(\x05R\freviewsGiven\x12>\n" +
+	"\x05sizes\x18\x06 \x03(\v2(.mr.v1.GetAnalyticsResponse.Metrics.SizeR\x05sizes\x1a4\n" +
+	"\x04Size\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x1aa\n" +
+	"\fNamedMetrics\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12=\n" +
+	"\ametrics\x18\x02 \x01(\v2#.mr.v1.GetAnalyticsResponse.MetricsR\ametrics2\xee\x12\n" +
 	"\rMergeRequests\x12w\n" +
-	"\x10GetMergeRequests\x12\x1e.mr.v1.GetMergeRequestsRequest\x1a\x1f.mr.v1.GetMergeRequestsResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/mr/v1/GetMergeRequestsB$Z\"github.com/vlanse/glmr/proto/mr/v1b\x06proto3"
+	"\x10GetMergeRequests\x12\x1e.mr.v1.GetMergeRequestsRequest\x1a\x1f.mr.v1.GetMergeRequestsResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/mr/v1/GetMergeRequests\x12x\n" +
+	"\x12WatchMergeRequests\x12 .mr.v1.WatchMergeRequestsRequest\x1a\x18.mr.v1.MergeRequestEvent\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/mr/v1/WatchMergeRequests0\x01\x12\x82\x01\n" +
+	"\x13ApproveMergeRequest\x12!.mr.v1.ApproveMergeRequestRequest\x1a!.mr.v1.MergeRequestActionResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/mr/v1/ApproveMergeRequest\x12\x88\x01\n" +
+	"\x15UnapproveMergeRequest\x12#.mr.v1.UnapproveMergeRequestRequest\x1a!.mr.v1.MergeRequestActionResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/mr/v1/UnapproveMergeRequest\x12|\n" +
+	"\x11MergeMergeRequest\x12\x1f.mr.v1.MergeMergeRequestRequest\x1a!.mr.v1.MergeRequestActionResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/mr/v1/MergeMergeRequest\x12\x7f\n" +
+	"\x12RebaseMergeRequest\x12 .mr.v1.RebaseMergeRequestRequest\x1a!.mr.v1.MergeRequestActionResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/mr/v1/RebaseMergeRequest\x12p\n" +
+	"\rRetryPipeline\x12\x1b.mr.v1.RetryPipelineRequest\x1a!.mr.v1.MergeRequestActionResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/mr/v1/RetryPipeline\x12a\n" +
+	"\bRetryJob\x12\x16.mr.v1.RetryJobRequest\x1a!.mr.v1.MergeRequestActionResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/mr/v1/RetryJob\x12\x85\x01\n" +
+	"\x14MarkMergeRequestSeen\x12\".mr.v1.MarkMergeRequestSeenRequest\x1a!.mr.v1.MergeRequestActionResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/mr/v1/MarkMergeRequestSeen\x12\x7f\n" +
+	"\x12SnoozeMergeRequest\x12 .mr.v1.SnoozeMergeRequestRequest\x1a!.mr.v1.MergeRequestActionResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/mr/v1/SnoozeMergeRequest\x12v\n" +
+	"\x0fPinMergeRequest\x12\x1d.mr.v1.PinMergeRequestRequest\x1a!.mr.v1.MergeRequestActionResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/mr/v1/PinMergeRequest\x12s\n" +
+	"\x0eSetPrivateNote\x12\x1c.mr.v1.SetPrivateNoteRequest\x1a!.mr.v1.MergeRequestActionResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/mr/v1/SetPrivateNote\x12^\n" +
+	"\aSetTags\x12\x15.mr.v1.SetTagsRequest\x1a!.mr.v1.MergeRequestActionResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/mr/v1/SetTags\x12s\n" +
+	"\x0eAssignReviewer\x12\x1c.mr.v1.AssignReviewerRequest\x1a!.mr.v1.MergeRequestActionResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/mr/v1/AssignReviewer\x12o\n" +
+	"\x0eGetDiscussions\x12\x1c.mr.v1.GetDiscussionsRequest\x1a\x1d.mr.v1.GetDiscussionsResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/mr/v1/GetDiscussions\x12_\n" +
+	"\n" +
+	"AddComment\x12\x18.mr.v1.AddCommentRequest\x1a\x19.mr.v1.DiscussionResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/mr/v1/AddComment\x12t\n" +
+	"\x11ReplyToDiscussion\x12\x1f.mr.v1.ReplyToDiscussionRequest\x1a\x19.mr.v1.DiscussionResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/mr/v1/ReplyToDiscussion\x12t\n" +
+	"\x11ResolveDiscussion\x12\x1f.mr.v1.ResolveDiscussionRequest\x1a\x19.mr.v1.DiscussionResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/mr/v1/ResolveDiscussion\x12g\n" +
+	"\fGetAnalytics\x12\x1a.mr.v1.GetAnalyticsRequest\x1a\x1b.mr.v1.GetAnalyticsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/mr/v1/GetAnalytics\x12\x93\x01\n" +
+	"\x17GetMergeRequestTimeline\x12%.mr.v1.GetMergeRequestTimelineRequest\x1a&.mr.v1.GetMergeRequestTimelineResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/mr/v1/GetMergeRequestTimelineB$Z\"github.com/vlanse/glmr/proto/mr/v1b\x06proto3"
 
 var (
 	file_mr_v1_mr_proto_rawDescOnce sync.Once
@@ -905,40 +4777,194 @@ func file_mr_v1_mr_proto_rawDescGZIP() []byte {
 	return file_mr_v1_mr_proto_rawDescData
 }
 
-var file_mr_v1_mr_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_mr_v1_mr_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_mr_v1_mr_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_mr_v1_mr_proto_goTypes = []any{
-	(*GetMergeRequestsRequest)(nil),                                // 0: mr.v1.GetMergeRequestsRequest
-	(*GetMergeRequestsResponse)(nil),                               // 1: mr.v1.GetMergeRequestsResponse
-	(*GetMergeRequestsRequest_Filter)(nil),                         // 2: mr.v1.GetMergeRequestsRequest.Filter
-	(*GetMergeRequestsResponse_MergeRequest)(nil),                  // 3: mr.v1.GetMergeRequestsResponse.MergeRequest
-	(*GetMergeRequestsResponse_Group)(nil),                         // 4: mr.v1.GetMergeRequestsResponse.Group
-	(*GetMergeRequestsResponse_MergeRequest_User)(nil),             // 5: mr.v1.GetMergeRequestsResponse.MergeRequest.User
-	(*GetMergeRequestsResponse_MergeRequest_Project)(nil),          // 6: mr.v1.GetMergeRequestsResponse.MergeRequest.Project
-	(*GetMergeRequestsResponse_MergeRequest_Status)(nil),           // 7: mr.v1.GetMergeRequestsResponse.MergeRequest.Status
-	(*GetMergeRequestsResponse_MergeRequest_Comments)(nil),         // 8: mr.v1.GetMergeRequestsResponse.MergeRequest.Comments
-	(*GetMergeRequestsResponse_MergeRequest_Issue)(nil),            // 9: mr.v1.GetMergeRequestsResponse.MergeRequest.Issue
-	(*GetMergeRequestsResponse_MergeRequest_DiffStatsSummary)(nil), // 10: mr.v1.GetMergeRequestsResponse.MergeRequest.DiffStatsSummary
-	(*GetMergeRequestsResponse_Group_Summary)(nil),                 // 11: mr.v1.GetMergeRequestsResponse.Group.Summary
+	(GetMergeRequestsRequest_Sort_By)(0),                                // 0: mr.v1.GetMergeRequestsRequest.Sort.By
+	(GetMergeRequestsResponse_MergeRequest_Status_SLAViolation_Type)(0), // 1: mr.v1.GetMergeRequestsResponse.MergeRequest.Status.SLAViolation.Type
+	(MergeRequestEvent_Type)(0),                                         // 2: mr.v1.MergeRequestEvent.Type
+	(GetMergeRequestTimelineResponse_Entry_Change)(0),                   // 3: mr.v1.GetMergeRequestTimelineResponse.Entry.Change
+	(*GetMergeRequestsRequest)(nil),                                     // 4: mr.v1.GetMergeRequestsRequest
+	(*GetMergeRequestsResponse)(nil),                                    // 5: mr.v1.GetMergeRequestsResponse
+	(*WatchMergeRequestsRequest)(nil),                                   // 6: mr.v1.WatchMergeRequestsRequest
+	(*MergeRequestEvent)(nil),                                           // 7: mr.v1.MergeRequestEvent
+	(*ApproveMergeRequestRequest)(nil),                                  // 8: mr.v1.ApproveMergeRequestRequest
+	(*UnapproveMergeRequestRequest)(nil),                                // 9: mr.v1.UnapproveMergeRequestRequest
+	(*MergeMergeRequestRequest)(nil),                                    // 10: mr.v1.MergeMergeRequestRequest
+	(*RebaseMergeRequestRequest)(nil),                                   // 11: mr.v1.RebaseMergeRequestRequest
+	(*RetryPipelineRequest)(nil),                                        // 12: mr.v1.RetryPipelineRequest
+	(*RetryJobRequest)(nil),                                             // 13: mr.v1.RetryJobRequest
+	(*AssignReviewerRequest)(nil),                                       // 14: mr.v1.AssignReviewerRequest
+	(*MarkMergeRequestSeenRequest)(nil),                                 // 15: mr.v1.MarkMergeRequestSeenRequest
+	(*SnoozeMergeRequestRequest)(nil),                                   // 16: mr.v1.SnoozeMergeRequestRequest
+	(*PinMergeRequestRequest)(nil),                                      // 17: mr.v1.PinMergeRequestRequest
+	(*SetPrivateNoteRequest)(nil),                                       // 18: mr.v1.SetPrivateNoteRequest
+	(*SetTagsRequest)(nil),                                              // 19: mr.v1.SetTagsRequest
+	(*MergeRequestActionResponse)(nil),                                  // 20: mr.v1.MergeRequestActionResponse
+	(*Discussion)(nil),                                                  // 21: mr.v1.Discussion
+	(*GetDiscussionsRequest)(nil),                                       // 22: mr.v1.GetDiscussionsRequest
+	(*GetDiscussionsResponse)(nil),                                      // 23: mr.v1.GetDiscussionsResponse
+	(*AddCommentRequest)(nil),                                           // 24: mr.v1.AddCommentRequest
+	(*ReplyToDiscussionRequest)(nil),                                    // 25: mr.v1.ReplyToDiscussionRequest
+	(*ResolveDiscussionRequest)(nil),                                    // 26: mr.v1.ResolveDiscussionRequest
+	(*DiscussionResponse)(nil),                                          // 27: mr.v1.DiscussionResponse
+	(*GetMergeRequestTimelineRequest)(nil),                              // 28: mr.v1.GetMergeRequestTimelineRequest
+	(*GetMergeRequestTimelineResponse)(nil),                             // 29: mr.v1.GetMergeRequestTimelineResponse
+	(*GetAnalyticsRequest)(nil),                                         // 30: mr.v1.GetAnalyticsRequest
+	(*GetAnalyticsResponse)(nil),                                        // 31: mr.v1.GetAnalyticsResponse
+	(*GetMergeRequestsRequest_Filter)(nil),                              // 32: mr.v1.GetMergeRequestsRequest.Filter
+	(*GetMergeRequestsRequest_Sort)(nil),                                // 33: mr.v1.GetMergeRequestsRequest.Sort
+	(*GetMergeRequestsResponse_MergeRequest)(nil),                       // 34: mr.v1.GetMergeRequestsResponse.MergeRequest
+	(*GetMergeRequestsResponse_Group)(nil),                              // 35: mr.v1.GetMergeRequestsResponse.Group
+	(*GetMergeRequestsResponse_MergeRequest_User)(nil),                  // 36: mr.v1.GetMergeRequestsResponse.MergeRequest.User
+	(*GetMergeRequestsResponse_MergeRequest_Project)(nil),               // 37: mr.v1.GetMergeRequestsResponse.MergeRequest.Project
+	(*GetMergeRequestsResponse_MergeRequest_Status)(nil),                // 38: mr.v1.GetMergeRequestsResponse.MergeRequest.Status
+	(*GetMergeRequestsResponse_MergeRequest_Comments)(nil),              // 39: mr.v1.GetMergeRequestsResponse.MergeRequest.Comments
+	(*GetMergeRequestsResponse_MergeRequest_Issue)(nil),                 // 40: mr.v1.GetMergeRequestsResponse.MergeRequest.Issue
+	(*GetMergeRequestsResponse_MergeRequest_DiffStatsSummary)(nil),      // 41: mr.v1.GetMergeRequestsResponse.MergeRequest.DiffStatsSummary
+	(*GetMergeRequestsResponse_MergeRequest_ApprovalRule)(nil),          // 42: mr.v1.GetMergeRequestsResponse.MergeRequest.ApprovalRule
+	(*GetMergeRequestsResponse_MergeRequest_Approvals)(nil),             // 43: mr.v1.GetMergeRequestsResponse.MergeRequest.Approvals
+	(*GetMergeRequestsResponse_MergeRequest_Approval)(nil),              // 44: mr.v1.GetMergeRequestsResponse.MergeRequest.Approval
+	(*GetMergeRequestsResponse_MergeRequest_Pipeline)(nil),              // 45: mr.v1.GetMergeRequestsResponse.MergeRequest.Pipeline
+	(*GetMergeRequestsResponse_MergeRequest_Label)(nil),                 // 46: mr.v1.GetMergeRequestsResponse.MergeRequest.Label
+	(*GetMergeRequestsResponse_MergeRequest_Milestone)(nil),             // 47: mr.v1.GetMergeRequestsResponse.MergeRequest.Milestone
+	(*GetMergeRequestsResponse_MergeRequest_ReviewerSuggestion)(nil),    // 48: mr.v1.GetMergeRequestsResponse.MergeRequest.ReviewerSuggestion
+	(*GetMergeRequestsResponse_MergeRequest_Marks)(nil),                 // 49: mr.v1.GetMergeRequestsResponse.MergeRequest.Marks
+	(*GetMergeRequestsResponse_MergeRequest_Highlight)(nil),             // 50: mr.v1.GetMergeRequestsResponse.MergeRequest.Highlight
+	(*GetMergeRequestsResponse_MergeRequest_ReviewMetrics)(nil),         // 51: mr.v1.GetMergeRequestsResponse.MergeRequest.ReviewMetrics
+	(*GetMergeRequestsResponse_MergeRequest_Status_SLAViolation)(nil),   // 52: mr.v1.GetMergeRequestsResponse.MergeRequest.Status.SLAViolation
+	(*GetMergeRequestsResponse_MergeRequest_Pipeline_Job)(nil),          // 53: mr.v1.GetMergeRequestsResponse.MergeRequest.Pipeline.Job
+	(*GetMergeRequestsResponse_Group_Summary)(nil),                      // 54: mr.v1.GetMergeRequestsResponse.Group.Summary
+	(*GetMergeRequestsResponse_Group_ProjectError)(nil),                 // 55: mr.v1.GetMergeRequestsResponse.Group.ProjectError
+	(*Discussion_Note)(nil),                                             // 56: mr.v1.Discussion.Note
+	(*GetMergeRequestTimelineResponse_Entry)(nil),                       // 57: mr.v1.GetMergeRequestTimelineResponse.Entry
+	(*GetAnalyticsResponse_Metrics)(nil),                                // 58: mr.v1.GetAnalyticsResponse.Metrics
+	(*GetAnalyticsResponse_NamedMetrics)(nil),                           // 59: mr.v1.GetAnalyticsResponse.NamedMetrics
+	(*GetAnalyticsResponse_Metrics_Size)(nil),                           // 60: mr.v1.GetAnalyticsResponse.Metrics.Size
+	(*timestamppb.Timestamp)(nil),                                       // 61: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                                         // 62: google.protobuf.Duration
 }
 var file_mr_v1_mr_proto_depIdxs = []int32{
-	2,  // 0: mr.v1.GetMergeRequestsRequest.filter:type_name -> mr.v1.GetMergeRequestsRequest.Filter
-	4,  // 1: mr.v1.GetMergeRequestsResponse.groups:type_name -> mr.v1.GetMergeRequestsResponse.Group
-	6,  // 2: mr.v1.GetMergeRequestsResponse.MergeRequest.project:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.Project
-	5,  // 3: mr.v1.GetMergeRequestsResponse.MergeRequest.author:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.User
-	7,  // 4: mr.v1.GetMergeRequestsResponse.MergeRequest.status:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.Status
-	5,  // 5: mr.v1.GetMergeRequestsResponse.MergeRequest.approvedBy:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.User
-	8,  // 6: mr.v1.GetMergeRequestsResponse.MergeRequest.comments:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.Comments
-	9,  // 7: mr.v1.GetMergeRequestsResponse.MergeRequest.issues:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.Issue
-	10, // 8: mr.v1.GetMergeRequestsResponse.MergeRequest.diffStatsSummary:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.DiffStatsSummary
-	3,  // 9: mr.v1.GetMergeRequestsResponse.Group.mergeRequests:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest
-	11, // 10: mr.v1.GetMergeRequestsResponse.Group.summary:type_name -> mr.v1.GetMergeRequestsResponse.Group.Summary
-	0,  // 11: mr.v1.MergeRequests.GetMergeRequests:input_type -> mr.v1.GetMergeRequestsRequest
-	1,  // 12: mr.v1.MergeRequests.GetMergeRequests:output_type -> mr.v1.GetMergeRequestsResponse
-	12, // [12:13] is the sub-list for method output_type
-	11, // [11:12] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	32, // 0: mr.v1.GetMergeRequestsRequest.filter:type_name -> mr.v1.GetMergeRequestsRequest.Filter
+	33, // 1: mr.v1.GetMergeRequestsRequest.sort:type_name -> mr.v1.GetMergeRequestsRequest.Sort
+	35, // 2: mr.v1.GetMergeRequestsResponse.groups:type_name -> mr.v1.GetMergeRequestsResponse.Group
+	61, // 3: mr.v1.GetMergeRequestsResponse.updatedAt:type_name -> google.protobuf.Timestamp
+	2,  // 4: mr.v1.MergeRequestEvent.type:type_name -> mr.v1.MergeRequestEvent.Type
+	61, // 5: mr.v1.MergeRequestEvent.occurredAt:type_name -> google.protobuf.Timestamp
+	34, // 6: mr.v1.MergeRequestEvent.mergeRequest:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest
+	36, // 7: mr.v1.MergeRequestEvent.user:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.User
+	61, // 8: mr.v1.SnoozeMergeRequestRequest.until:type_name -> google.protobuf.Timestamp
+	34, // 9: mr.v1.MergeRequestActionResponse.mergeRequest:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest
+	56, // 10: mr.v1.Discussion.notes:type_name -> mr.v1.Discussion.Note
+	21, // 11: mr.v1.GetDiscussionsResponse.discussions:type_name -> mr.v1.Discussion
+	21, // 12: mr.v1.DiscussionResponse.discussion:type_name -> mr.v1.Discussion
+	61, // 13: mr.v1.GetMergeRequestTimelineResponse.createdAt:type_name -> google.protobuf.Timestamp
+	57, // 14: mr.v1.GetMergeRequestTimelineResponse.entries:type_name -> mr.v1.GetMergeRequestTimelineResponse.Entry
+	61, // 15: mr.v1.GetAnalyticsRequest.from:type_name -> google.protobuf.Timestamp
+	61, // 16: mr.v1.GetAnalyticsRequest.to:type_name -> google.protobuf.Timestamp
+	61, // 17: mr.v1.GetAnalyticsResponse.from:type_name -> google.protobuf.Timestamp
+	61, // 18: mr.v1.GetAnalyticsResponse.to:type_name -> google.protobuf.Timestamp
+	58, // 19: mr.v1.GetAnalyticsResponse.total:type_name -> mr.v1.GetAnalyticsResponse.Metrics
+	59, // 20: mr.v1.GetAnalyticsResponse.groups:type_name -> mr.v1.GetAnalyticsResponse.NamedMetrics
+	59, // 21: mr.v1.GetAnalyticsResponse.people:type_name -> mr.v1.GetAnalyticsResponse.NamedMetrics
+	62, // 22: mr.v1.GetMergeRequestsRequest.Filter.minAge:type_name -> google.protobuf.Duration
+	62, // 23: mr.v1.GetMergeRequestsRequest.Filter.maxAge:type_name -> google.protobuf.Duration
+	0,  // 24: mr.v1.GetMergeRequestsRequest.Sort.by:type_name -> mr.v1.GetMergeRequestsRequest.Sort.By
+	37, // 25: mr.v1.GetMergeRequestsResponse.MergeRequest.project:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.Project
+	36, // 26: mr.v1.GetMergeRequestsResponse.MergeRequest.author:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.User
+	38, // 27: mr.v1.GetMergeRequestsResponse.MergeRequest.status:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.Status
+	36, // 28: mr.v1.GetMergeRequestsResponse.MergeRequest.approvedBy:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.User
+	39, // 29: mr.v1.GetMergeRequestsResponse.MergeRequest.comments:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.Comments
+	40, // 30: mr.v1.GetMergeRequestsResponse.MergeRequest.issues:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.Issue
+	41, // 31: mr.v1.GetMergeRequestsResponse.MergeRequest.diffStatsSummary:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.DiffStatsSummary
+	43, // 32: mr.v1.GetMergeRequestsResponse.MergeRequest.approvals:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.Approvals
+	44, // 33: mr.v1.GetMergeRequestsResponse.MergeRequest.approvalsGiven:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.Approval
+	51, // 34: mr.v1.GetMergeRequestsResponse.MergeRequest.reviewMetrics:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.ReviewMetrics
+	45, // 35: mr.v1.GetMergeRequestsResponse.MergeRequest.pipeline:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.Pipeline
+	50, // 36: mr.v1.GetMergeRequestsResponse.MergeRequest.highlights:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.Highlight
+	46, // 37: mr.v1.GetMergeRequestsResponse.MergeRequest.labels:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.Label
+	47, // 38: mr.v1.GetMergeRequestsResponse.MergeRequest.milestone:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.Milestone
+	36, // 39: mr.v1.GetMergeRequestsResponse.MergeRequest.reviewers:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.User
+	36, // 40: mr.v1.GetMergeRequestsResponse.MergeRequest.assignees:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.User
+	48, // 41: mr.v1.GetMergeRequestsResponse.MergeRequest.suggestedReviewers:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.ReviewerSuggestion
+	49, // 42: mr.v1.GetMergeRequestsResponse.MergeRequest.marks:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.Marks
+	34, // 43: mr.v1.GetMergeRequestsResponse.Group.mergeRequests:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest
+	54, // 44: mr.v1.GetMergeRequestsResponse.Group.summary:type_name -> mr.v1.GetMergeRequestsResponse.Group.Summary
+	55, // 45: mr.v1.GetMergeRequestsResponse.Group.errors:type_name -> mr.v1.GetMergeRequestsResponse.Group.ProjectError
+	52, // 46: mr.v1.GetMergeRequestsResponse.MergeRequest.Status.slaViolations:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.Status.SLAViolation
+	36, // 47: mr.v1.GetMergeRequestsResponse.MergeRequest.ApprovalRule.approvedBy:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.User
+	36, // 48: mr.v1.GetMergeRequestsResponse.MergeRequest.ApprovalRule.eligibleApprovers:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.User
+	42, // 49: mr.v1.GetMergeRequestsResponse.MergeRequest.Approvals.rules:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.ApprovalRule
+	36, // 50: mr.v1.GetMergeRequestsResponse.MergeRequest.Approval.user:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.User
+	61, // 51: mr.v1.GetMergeRequestsResponse.MergeRequest.Approval.approvedAt:type_name -> google.protobuf.Timestamp
+	62, // 52: mr.v1.GetMergeRequestsResponse.MergeRequest.Pipeline.duration:type_name -> google.protobuf.Duration
+	53, // 53: mr.v1.GetMergeRequestsResponse.MergeRequest.Pipeline.failedJobs:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.Pipeline.Job
+	61, // 54: mr.v1.GetMergeRequestsResponse.MergeRequest.Milestone.dueDate:type_name -> google.protobuf.Timestamp
+	36, // 55: mr.v1.GetMergeRequestsResponse.MergeRequest.ReviewerSuggestion.user:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.User
+	61, // 56: mr.v1.GetMergeRequestsResponse.MergeRequest.Marks.snoozedUntil:type_name -> google.protobuf.Timestamp
+	62, // 57: mr.v1.GetMergeRequestsResponse.MergeRequest.ReviewMetrics.timeToFirstComment:type_name -> google.protobuf.Duration
+	62, // 58: mr.v1.GetMergeRequestsResponse.MergeRequest.ReviewMetrics.timeToFirstApproval:type_name -> google.protobuf.Duration
+	62, // 59: mr.v1.GetMergeRequestsResponse.MergeRequest.ReviewMetrics.timeToRequiredApprovals:type_name -> google.protobuf.Duration
+	1,  // 60: mr.v1.GetMergeRequestsResponse.MergeRequest.Status.SLAViolation.type:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.Status.SLAViolation.Type
+	62, // 61: mr.v1.GetMergeRequestsResponse.MergeRequest.Status.SLAViolation.limit:type_name -> google.protobuf.Duration
+	62, // 62: mr.v1.GetMergeRequestsResponse.MergeRequest.Status.SLAViolation.actual:type_name -> google.protobuf.Duration
+	62, // 63: mr.v1.GetMergeRequestsResponse.MergeRequest.Pipeline.Job.duration:type_name -> google.protobuf.Duration
+	61, // 64: mr.v1.GetMergeRequestsResponse.Group.ProjectError.lastSuccessAt:type_name -> google.protobuf.Timestamp
+	36, // 65: mr.v1.Discussion.Note.author:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.User
+	36, // 66: mr.v1.Discussion.Note.resolvedBy:type_name -> mr.v1.GetMergeRequestsResponse.MergeRequest.User
+	61, // 67: mr.v1.Discussion.Note.createdAt:type_name -> google.protobuf.Timestamp
+	61, // 68: mr.v1.Discussion.Note.resolvedAt:type_name -> google.protobuf.Timestamp
+	61, // 69: mr.v1.GetMergeRequestTimelineResponse.Entry.recordedAt:type_name -> google.protobuf.Timestamp
+	3,  // 70: mr.v1.GetMergeRequestTimelineResponse.Entry.changes:type_name -> mr.v1.GetMergeRequestTimelineResponse.Entry.Change
+	62, // 71: mr.v1.GetAnalyticsResponse.Metrics.medianTimeToFirstReview:type_name -> google.protobuf.Duration
+	62, // 72: mr.v1.GetAnalyticsResponse.Metrics.medianTimeToMerge:type_name -> google.protobuf.Duration
+	60, // 73: mr.v1.GetAnalyticsResponse.Metrics.sizes:type_name -> mr.v1.GetAnalyticsResponse.Metrics.Size
+	58, // 74: mr.v1.GetAnalyticsResponse.NamedMetrics.metrics:type_name -> mr.v1.GetAnalyticsResponse.Metrics
+	4,  // 75: mr.v1.MergeRequests.GetMergeRequests:input_type -> mr.v1.GetMergeRequestsRequest
+	6,  // 76: mr.v1.MergeRequests.WatchMergeRequests:input_type -> mr.v1.WatchMergeRequestsRequest
+	8,  // 77: mr.v1.MergeRequests.ApproveMergeRequest:input_type -> mr.v1.ApproveMergeRequestRequest
+	9,  // 78: mr.v1.MergeRequests.UnapproveMergeRequest:input_type -> mr.v1.UnapproveMergeRequestRequest
+	10, // 79: mr.v1.MergeRequests.MergeMergeRequest:input_type -> mr.v1.MergeMergeRequestRequest
+	11, // 80: mr.v1.MergeRequests.RebaseMergeRequest:input_type -> mr.v1.RebaseMergeRequestRequest
+	12, // 81: mr.v1.MergeRequests.RetryPipeline:input_type -> mr.v1.RetryPipelineRequest
+	13, // 82: mr.v1.MergeRequests.RetryJob:input_type -> mr.v1.RetryJobRequest
+	15, // 83: mr.v1.MergeRequests.MarkMergeRequestSeen:input_type -> mr.v1.MarkMergeRequestSeenRequest
+	16, // 84: mr.v1.MergeRequests.SnoozeMergeRequest:input_type -> mr.v1.SnoozeMergeRequestRequest
+	17, // 85: mr.v1.MergeRequests.PinMergeRequest:input_type -> mr.v1.PinMergeRequestRequest
+	18, // 86: mr.v1.MergeRequests.SetPrivateNote:input_type -> mr.v1.SetPrivateNoteRequest
+	19, // 87: mr.v1.MergeRequests.SetTags:input_type -> mr.v1.SetTagsRequest
+	14, // 88: mr.v1.MergeRequests.AssignReviewer:input_type -> mr.v1.AssignReviewerRequest
+	22, // 89: mr.v1.MergeRequests.GetDiscussions:input_type -> mr.v1.GetDiscussionsRequest
+	24, // 90: mr.v1.MergeRequests.AddComment:input_type -> mr.v1.AddCommentRequest
+	25, // 91: mr.v1.MergeRequests.ReplyToDiscussion:input_type -> mr.v1.ReplyToDiscussionRequest
+	26, // 92: mr.v1.MergeRequests.ResolveDiscussion:input_type -> mr.v1.ResolveDiscussionRequest
+	30, // 93: mr.v1.MergeRequests.GetAnalytics:input_type -> mr.v1.GetAnalyticsRequest
+	28, // 94: mr.v1.MergeRequests.GetMergeRequestTimeline:input_type -> mr.v1.GetMergeRequestTimelineRequest
+	5,  // 95: mr.v1.MergeRequests.GetMergeRequests:output_type -> mr.v1.GetMergeRequestsResponse
+	7,  // 96: mr.v1.MergeRequests.WatchMergeRequests:output_type -> mr.v1.MergeRequestEvent
+	20, // 97: mr.v1.MergeRequests.ApproveMergeRequest:output_type -> mr.v1.MergeRequestActionResponse
+	20, // 98: mr.v1.MergeRequests.UnapproveMergeRequest:output_type -> mr.v1.MergeRequestActionResponse
+	20, // 99: mr.v1.MergeRequests.MergeMergeRequest:output_type -> mr.v1.MergeRequestActionResponse
+	20, // 100: mr.v1.MergeRequests.RebaseMergeRequest:output_type -> mr.v1.MergeRequestActionResponse
+	20, // 101: mr.v1.MergeRequests.RetryPipeline:output_type -> mr.v1.MergeRequestActionResponse
+	20, // 102: mr.v1.MergeRequests.RetryJob:output_type -> mr.v1.MergeRequestActionResponse
+	20, // 103: mr.v1.MergeRequests.MarkMergeRequestSeen:output_type -> mr.v1.MergeRequestActionResponse
+	20, // 104: mr.v1.MergeRequests.SnoozeMergeRequest:output_type -> mr.v1.MergeRequestActionResponse
+	20, // 105: mr.v1.MergeRequests.PinMergeRequest:output_type -> mr.v1.MergeRequestActionResponse
+	20, // 106: mr.v1.MergeRequests.SetPrivateNote:output_type -> mr.v1.MergeRequestActionResponse
+	20, // 107: mr.v1.MergeRequests.SetTags:output_type -> mr.v1.MergeRequestActionResponse
+	20, // 108: mr.v1.MergeRequests.AssignReviewer:output_type -> mr.v1.MergeRequestActionResponse
+	23, // 109: mr.v1.MergeRequests.GetDiscussions:output_type -> mr.v1.GetDiscussionsResponse
+	27, // 110: mr.v1.MergeRequests.AddComment:output_type -> mr.v1.DiscussionResponse
+	27, // 111: mr.v1.MergeRequests.ReplyToDiscussion:output_type -> mr.v1.DiscussionResponse
+	27, // 112: mr.v1.MergeRequests.ResolveDiscussion:output_type -> mr.v1.DiscussionResponse
+	31, // 113: mr.v1.MergeRequests.GetAnalytics:output_type -> mr.v1.GetAnalyticsResponse
+	29, // 114: mr.v1.MergeRequests.GetMergeRequestTimeline:output_type -> mr.v1.GetMergeRequestTimelineResponse
+	95, // [95:115] is the sub-list for method output_type
+	75, // [75:95] is the sub-list for method input_type
+	75, // [75:75] is the sub-list for extension type_name
+	75, // [75:75] is the sub-list for extension extendee
+	0,  // [0:75] is the sub-list for field type_name
 }
 
 func init() { file_mr_v1_mr_proto_init() }
@@ -946,18 +4972,20 @@ func file_mr_v1_mr_proto_init() {
 	if File_mr_v1_mr_proto != nil {
 		return
 	}
+	file_mr_v1_mr_proto_msgTypes[28].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mr_v1_mr_proto_rawDesc), len(file_mr_v1_mr_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      4,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_mr_v1_mr_proto_goTypes,
 		DependencyIndexes: file_mr_v1_mr_proto_depIdxs,
+		EnumInfos:         file_mr_v1_mr_proto_enumTypes,
 		MessageInfos:      file_mr_v1_mr_proto_msgTypes,
 	}.Build()
 	File_mr_v1_mr_proto = out.File
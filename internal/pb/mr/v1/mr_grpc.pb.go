@@ -19,7 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MergeRequests_GetMergeRequests_FullMethodName = "/mr.v1.MergeRequests/GetMergeRequests"
+	MergeRequests_GetMergeRequests_FullMethodName        = "/mr.v1.MergeRequests/GetMergeRequests"
+	MergeRequests_WatchMergeRequests_FullMethodName      = "/mr.v1.MergeRequests/WatchMergeRequests"
+	MergeRequests_ApproveMergeRequest_FullMethodName     = "/mr.v1.MergeRequests/ApproveMergeRequest"
+	MergeRequests_UnapproveMergeRequest_FullMethodName   = "/mr.v1.MergeRequests/UnapproveMergeRequest"
+	MergeRequests_MergeMergeRequest_FullMethodName       = "/mr.v1.MergeRequests/MergeMergeRequest"
+	MergeRequests_RebaseMergeRequest_FullMethodName      = "/mr.v1.MergeRequests/RebaseMergeRequest"
+	MergeRequests_RetryPipeline_FullMethodName           = "/mr.v1.MergeRequests/RetryPipeline"
+	MergeRequests_RetryJob_FullMethodName                = "/mr.v1.MergeRequests/RetryJob"
+	MergeRequests_MarkMergeRequestSeen_FullMethodName    = "/mr.v1.MergeRequests/MarkMergeRequestSeen"
+	MergeRequests_SnoozeMergeRequest_FullMethodName      = "/mr.v1.MergeRequests/SnoozeMergeRequest"
+	MergeRequests_PinMergeRequest_FullMethodName         = "/mr.v1.MergeRequests/PinMergeRequest"
+	MergeRequests_SetPrivateNote_FullMethodName          = "/mr.v1.MergeRequests/SetPrivateNote"
+	MergeRequests_SetTags_FullMethodName                 = "/mr.v1.MergeRequests/SetTags"
+	MergeRequests_AssignReviewer_FullMethodName          = "/mr.v1.MergeRequests/AssignReviewer"
+	MergeRequests_GetDiscussions_FullMethodName          = "/mr.v1.MergeRequests/GetDiscussions"
+	MergeRequests_AddComment_FullMethodName              = "/mr.v1.MergeRequests/AddComment"
+	MergeRequests_ReplyToDiscussion_FullMethodName       = "/mr.v1.MergeRequests/ReplyToDiscussion"
+	MergeRequests_ResolveDiscussion_FullMethodName       = "/mr.v1.MergeRequests/ResolveDiscussion"
+	MergeRequests_GetAnalytics_FullMethodName            = "/mr.v1.MergeRequests/GetAnalytics"
+	MergeRequests_GetMergeRequestTimeline_FullMethodName = "/mr.v1.MergeRequests/GetMergeRequestTimeline"
 )
 
 // MergeRequestsClient is the client API for MergeRequests service.
@@ -27,6 +46,42 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MergeRequestsClient interface {
 	GetMergeRequests(ctx context.Context, in *GetMergeRequestsRequest, opts ...grpc.CallOption) (*GetMergeRequestsResponse, error)
+	// WatchMergeRequests streams changes of merge requests detected between consecutive background refreshes,
+	// events are also available as server-sent events at GET /mr/v1/events
+	WatchMergeRequests(ctx context.Context, in *WatchMergeRequestsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MergeRequestEvent], error)
+	ApproveMergeRequest(ctx context.Context, in *ApproveMergeRequestRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error)
+	// UnapproveMergeRequest revokes approval of the current user
+	UnapproveMergeRequest(ctx context.Context, in *UnapproveMergeRequestRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error)
+	MergeMergeRequest(ctx context.Context, in *MergeMergeRequestRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error)
+	// RebaseMergeRequest starts rebase which is done by gitlab asynchronously
+	RebaseMergeRequest(ctx context.Context, in *RebaseMergeRequestRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error)
+	// RetryPipeline retries failed jobs of merge request pipeline
+	RetryPipeline(ctx context.Context, in *RetryPipelineRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error)
+	RetryJob(ctx context.Context, in *RetryJobRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error)
+	// MarkMergeRequestSeen marks merge request as seen until its next commit, comment or approval, or clears the mark
+	MarkMergeRequestSeen(ctx context.Context, in *MarkMergeRequestSeenRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error)
+	// SnoozeMergeRequest hides merge request until date or until its next commit, comment or approval
+	SnoozeMergeRequest(ctx context.Context, in *SnoozeMergeRequestRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error)
+	// PinMergeRequest pins merge request to the top of its group
+	PinMergeRequest(ctx context.Context, in *PinMergeRequestRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error)
+	// SetPrivateNote saves markdown note of any merge request locally, empty note deletes it
+	SetPrivateNote(ctx context.Context, in *SetPrivateNoteRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error)
+	// SetTags replaces private tags of any merge request, tags are trimmed and deduplicated
+	SetTags(ctx context.Context, in *SetTagsRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error)
+	// AssignReviewer adds reviewer to merge request keeping already assigned ones, i.e. one of suggested reviewers
+	AssignReviewer(ctx context.Context, in *AssignReviewerRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error)
+	// GetDiscussions returns all threads of merge request with full notes, fetched from gitlab directly
+	GetDiscussions(ctx context.Context, in *GetDiscussionsRequest, opts ...grpc.CallOption) (*GetDiscussionsResponse, error)
+	// AddComment starts new thread on merge request
+	AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*DiscussionResponse, error)
+	ReplyToDiscussion(ctx context.Context, in *ReplyToDiscussionRequest, opts ...grpc.CallOption) (*DiscussionResponse, error)
+	// ResolveDiscussion resolves or unresolves the thread
+	ResolveDiscussion(ctx context.Context, in *ResolveDiscussionRequest, opts ...grpc.CallOption) (*DiscussionResponse, error)
+	// GetAnalytics returns team review metrics computed from locally recorded history,
+	// the same data is available as CSV at GET /mr/v1/analytics.csv?from=YYYY-MM-DD&to=YYYY-MM-DD
+	GetAnalytics(ctx context.Context, in *GetAnalyticsRequest, opts ...grpc.CallOption) (*GetAnalyticsResponse, error)
+	// GetMergeRequestTimeline returns locally recorded history of merge request states, i.e. to find out when pipeline failed
+	GetMergeRequestTimeline(ctx context.Context, in *GetMergeRequestTimelineRequest, opts ...grpc.CallOption) (*GetMergeRequestTimelineResponse, error)
 }
 
 type mergeRequestsClient struct {
@@ -47,11 +102,246 @@ func (c *mergeRequestsClient) GetMergeRequests(ctx context.Context, in *GetMerge
 	return out, nil
 }
 
+func (c *mergeRequestsClient) WatchMergeRequests(ctx context.Context, in *WatchMergeRequestsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MergeRequestEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MergeRequests_ServiceDesc.Streams[0], MergeRequests_WatchMergeRequests_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchMergeRequestsRequest, MergeRequestEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MergeRequests_WatchMergeRequestsClient = grpc.ServerStreamingClient[MergeRequestEvent]
+
+func (c *mergeRequestsClient) ApproveMergeRequest(ctx context.Context, in *ApproveMergeRequestRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeRequestActionResponse)
+	err := c.cc.Invoke(ctx, MergeRequests_ApproveMergeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mergeRequestsClient) UnapproveMergeRequest(ctx context.Context, in *UnapproveMergeRequestRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeRequestActionResponse)
+	err := c.cc.Invoke(ctx, MergeRequests_UnapproveMergeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mergeRequestsClient) MergeMergeRequest(ctx context.Context, in *MergeMergeRequestRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeRequestActionResponse)
+	err := c.cc.Invoke(ctx, MergeRequests_MergeMergeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mergeRequestsClient) RebaseMergeRequest(ctx context.Context, in *RebaseMergeRequestRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeRequestActionResponse)
+	err := c.cc.Invoke(ctx, MergeRequests_RebaseMergeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mergeRequestsClient) RetryPipeline(ctx context.Context, in *RetryPipelineRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeRequestActionResponse)
+	err := c.cc.Invoke(ctx, MergeRequests_RetryPipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mergeRequestsClient) RetryJob(ctx context.Context, in *RetryJobRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeRequestActionResponse)
+	err := c.cc.Invoke(ctx, MergeRequests_RetryJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mergeRequestsClient) MarkMergeRequestSeen(ctx context.Context, in *MarkMergeRequestSeenRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeRequestActionResponse)
+	err := c.cc.Invoke(ctx, MergeRequests_MarkMergeRequestSeen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mergeRequestsClient) SnoozeMergeRequest(ctx context.Context, in *SnoozeMergeRequestRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeRequestActionResponse)
+	err := c.cc.Invoke(ctx, MergeRequests_SnoozeMergeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mergeRequestsClient) PinMergeRequest(ctx context.Context, in *PinMergeRequestRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeRequestActionResponse)
+	err := c.cc.Invoke(ctx, MergeRequests_PinMergeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mergeRequestsClient) SetPrivateNote(ctx context.Context, in *SetPrivateNoteRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeRequestActionResponse)
+	err := c.cc.Invoke(ctx, MergeRequests_SetPrivateNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mergeRequestsClient) SetTags(ctx context.Context, in *SetTagsRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeRequestActionResponse)
+	err := c.cc.Invoke(ctx, MergeRequests_SetTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mergeRequestsClient) AssignReviewer(ctx context.Context, in *AssignReviewerRequest, opts ...grpc.CallOption) (*MergeRequestActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeRequestActionResponse)
+	err := c.cc.Invoke(ctx, MergeRequests_AssignReviewer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mergeRequestsClient) GetDiscussions(ctx context.Context, in *GetDiscussionsRequest, opts ...grpc.CallOption) (*GetDiscussionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDiscussionsResponse)
+	err := c.cc.Invoke(ctx, MergeRequests_GetDiscussions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mergeRequestsClient) AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*DiscussionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscussionResponse)
+	err := c.cc.Invoke(ctx, MergeRequests_AddComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mergeRequestsClient) ReplyToDiscussion(ctx context.Context, in *ReplyToDiscussionRequest, opts ...grpc.CallOption) (*DiscussionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscussionResponse)
+	err := c.cc.Invoke(ctx, MergeRequests_ReplyToDiscussion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mergeRequestsClient) ResolveDiscussion(ctx context.Context, in *ResolveDiscussionRequest, opts ...grpc.CallOption) (*DiscussionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscussionResponse)
+	err := c.cc.Invoke(ctx, MergeRequests_ResolveDiscussion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mergeRequestsClient) GetAnalytics(ctx context.Context, in *GetAnalyticsRequest, opts ...grpc.CallOption) (*GetAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAnalyticsResponse)
+	err := c.cc.Invoke(ctx, MergeRequests_GetAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mergeRequestsClient) GetMergeRequestTimeline(ctx context.Context, in *GetMergeRequestTimelineRequest, opts ...grpc.CallOption) (*GetMergeRequestTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMergeRequestTimelineResponse)
+	err := c.cc.Invoke(ctx, MergeRequests_GetMergeRequestTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MergeRequestsServer is the server API for MergeRequests service.
 // All implementations must embed UnimplementedMergeRequestsServer
 // for forward compatibility.
 type MergeRequestsServer interface {
 	GetMergeRequests(context.Context, *GetMergeRequestsRequest) (*GetMergeRequestsResponse, error)
+	// WatchMergeRequests streams changes of merge requests detected between consecutive background refreshes,
+	// events are also available as server-sent events at GET /mr/v1/events
+	WatchMergeRequests(*WatchMergeRequestsRequest, grpc.ServerStreamingServer[MergeRequestEvent]) error
+	ApproveMergeRequest(context.Context, *ApproveMergeRequestRequest) (*MergeRequestActionResponse, error)
+	// UnapproveMergeRequest revokes approval of the current user
+	UnapproveMergeRequest(context.Context, *UnapproveMergeRequestRequest) (*MergeRequestActionResponse, error)
+	MergeMergeRequest(context.Context, *MergeMergeRequestRequest) (*MergeRequestActionResponse, error)
+	// RebaseMergeRequest starts rebase which is done by gitlab asynchronously
+	RebaseMergeRequest(context.Context, *RebaseMergeRequestRequest) (*MergeRequestActionResponse, error)
+	// RetryPipeline retries failed jobs of merge request pipeline
+	RetryPipeline(context.Context, *RetryPipelineRequest) (*MergeRequestActionResponse, error)
+	RetryJob(context.Context, *RetryJobRequest) (*MergeRequestActionResponse, error)
+	// MarkMergeRequestSeen marks merge request as seen until its next commit, comment or approval, or clears the mark
+	MarkMergeRequestSeen(context.Context, *MarkMergeRequestSeenRequest) (*MergeRequestActionResponse, error)
+	// SnoozeMergeRequest hides merge request until date or until its next commit, comment or approval
+	SnoozeMergeRequest(context.Context, *SnoozeMergeRequestRequest) (*MergeRequestActionResponse, error)
+	// PinMergeRequest pins merge request to the top of its group
+	PinMergeRequest(context.Context, *PinMergeRequestRequest) (*MergeRequestActionResponse, error)
+	// SetPrivateNote saves markdown note of any merge request locally, empty note deletes it
+	SetPrivateNote(context.Context, *SetPrivateNoteRequest) (*MergeRequestActionResponse, error)
+	// SetTags replaces private tags of any merge request, tags are trimmed and deduplicated
+	SetTags(context.Context, *SetTagsRequest) (*MergeRequestActionResponse, error)
+	// AssignReviewer adds reviewer to merge request keeping already assigned ones, i.e. one of suggested reviewers
+	AssignReviewer(context.Context, *AssignReviewerRequest) (*MergeRequestActionResponse, error)
+	// GetDiscussions returns all threads of merge request with full notes, fetched from gitlab directly
+	GetDiscussions(context.Context, *GetDiscussionsRequest) (*GetDiscussionsResponse, error)
+	// AddComment starts new thread on merge request
+	AddComment(context.Context, *AddCommentRequest) (*DiscussionResponse, error)
+	ReplyToDiscussion(context.Context, *ReplyToDiscussionRequest) (*DiscussionResponse, error)
+	// ResolveDiscussion resolves or unresolves the thread
+	ResolveDiscussion(context.Context, *ResolveDiscussionRequest) (*DiscussionResponse, error)
+	// GetAnalytics returns team review metrics computed from locally recorded history,
+	// the same data is available as CSV at GET /mr/v1/analytics.csv?from=YYYY-MM-DD&to=YYYY-MM-DD
+	GetAnalytics(context.Context, *GetAnalyticsRequest) (*GetAnalyticsResponse, error)
+	// GetMergeRequestTimeline returns locally recorded history of merge request states, i.e. to find out when pipeline failed
+	GetMergeRequestTimeline(context.Context, *GetMergeRequestTimelineRequest) (*GetMergeRequestTimelineResponse, error)
 	mustEmbedUnimplementedMergeRequestsServer()
 }
 
@@ -65,6 +355,63 @@ type UnimplementedMergeRequestsServer struct{}
 func (UnimplementedMergeRequestsServer) GetMergeRequests(context.Context, *GetMergeRequestsRequest) (*GetMergeRequestsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMergeRequests not implemented")
 }
+func (UnimplementedMergeRequestsServer) WatchMergeRequests(*WatchMergeRequestsRequest, grpc.ServerStreamingServer[MergeRequestEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMergeRequests not implemented")
+}
+func (UnimplementedMergeRequestsServer) ApproveMergeRequest(context.Context, *ApproveMergeRequestRequest) (*MergeRequestActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveMergeRequest not implemented")
+}
+func (UnimplementedMergeRequestsServer) UnapproveMergeRequest(context.Context, *UnapproveMergeRequestRequest) (*MergeRequestActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnapproveMergeRequest not implemented")
+}
+func (UnimplementedMergeRequestsServer) MergeMergeRequest(context.Context, *MergeMergeRequestRequest) (*MergeRequestActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeMergeRequest not implemented")
+}
+func (UnimplementedMergeRequestsServer) RebaseMergeRequest(context.Context, *RebaseMergeRequestRequest) (*MergeRequestActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebaseMergeRequest not implemented")
+}
+func (UnimplementedMergeRequestsServer) RetryPipeline(context.Context, *RetryPipelineRequest) (*MergeRequestActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryPipeline not implemented")
+}
+func (UnimplementedMergeRequestsServer) RetryJob(context.Context, *RetryJobRequest) (*MergeRequestActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryJob not implemented")
+}
+func (UnimplementedMergeRequestsServer) MarkMergeRequestSeen(context.Context, *MarkMergeRequestSeenRequest) (*MergeRequestActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkMergeRequestSeen not implemented")
+}
+func (UnimplementedMergeRequestsServer) SnoozeMergeRequest(context.Context, *SnoozeMergeRequestRequest) (*MergeRequestActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnoozeMergeRequest not implemented")
+}
+func (UnimplementedMergeRequestsServer) PinMergeRequest(context.Context, *PinMergeRequestRequest) (*MergeRequestActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinMergeRequest not implemented")
+}
+func (UnimplementedMergeRequestsServer) SetPrivateNote(context.Context, *SetPrivateNoteRequest) (*MergeRequestActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrivateNote not implemented")
+}
+func (UnimplementedMergeRequestsServer) SetTags(context.Context, *SetTagsRequest) (*MergeRequestActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTags not implemented")
+}
+func (UnimplementedMergeRequestsServer) AssignReviewer(context.Context, *AssignReviewerRequest) (*MergeRequestActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignReviewer not implemented")
+}
+func (UnimplementedMergeRequestsServer) GetDiscussions(context.Context, *GetDiscussionsRequest) (*GetDiscussionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiscussions not implemented")
+}
+func (UnimplementedMergeRequestsServer) AddComment(context.Context, *AddCommentRequest) (*DiscussionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddComment not implemented")
+}
+func (UnimplementedMergeRequestsServer) ReplyToDiscussion(context.Context, *ReplyToDiscussionRequest) (*DiscussionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyToDiscussion not implemented")
+}
+func (UnimplementedMergeRequestsServer) ResolveDiscussion(context.Context, *ResolveDiscussionRequest) (*DiscussionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDiscussion not implemented")
+}
+func (UnimplementedMergeRequestsServer) GetAnalytics(context.Context, *GetAnalyticsRequest) (*GetAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnalytics not implemented")
+}
+func (UnimplementedMergeRequestsServer) GetMergeRequestTimeline(context.Context, *GetMergeRequestTimelineRequest) (*GetMergeRequestTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMergeRequestTimeline not implemented")
+}
 func (UnimplementedMergeRequestsServer) mustEmbedUnimplementedMergeRequestsServer() {}
 func (UnimplementedMergeRequestsServer) testEmbeddedByValue()                       {}
 
@@ -104,6 +451,341 @@ func _MergeRequests_GetMergeRequests_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MergeRequests_WatchMergeRequests_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMergeRequestsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MergeRequestsServer).WatchMergeRequests(m, &grpc.GenericServerStream[WatchMergeRequestsRequest, MergeRequestEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MergeRequests_WatchMergeRequestsServer = grpc.ServerStreamingServer[MergeRequestEvent]
+
+func _MergeRequests_ApproveMergeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveMergeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MergeRequestsServer).ApproveMergeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MergeRequests_ApproveMergeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MergeRequestsServer).ApproveMergeRequest(ctx, req.(*ApproveMergeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MergeRequests_UnapproveMergeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnapproveMergeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MergeRequestsServer).UnapproveMergeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MergeRequests_UnapproveMergeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MergeRequestsServer).UnapproveMergeRequest(ctx, req.(*UnapproveMergeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MergeRequests_MergeMergeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeMergeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MergeRequestsServer).MergeMergeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MergeRequests_MergeMergeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MergeRequestsServer).MergeMergeRequest(ctx, req.(*MergeMergeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MergeRequests_RebaseMergeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebaseMergeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MergeRequestsServer).RebaseMergeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MergeRequests_RebaseMergeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MergeRequestsServer).RebaseMergeRequest(ctx, req.(*RebaseMergeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MergeRequests_RetryPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MergeRequestsServer).RetryPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MergeRequests_RetryPipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MergeRequestsServer).RetryPipeline(ctx, req.(*RetryPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MergeRequests_RetryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MergeRequestsServer).RetryJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MergeRequests_RetryJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MergeRequestsServer).RetryJob(ctx, req.(*RetryJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MergeRequests_MarkMergeRequestSeen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkMergeRequestSeenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MergeRequestsServer).MarkMergeRequestSeen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MergeRequests_MarkMergeRequestSeen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MergeRequestsServer).MarkMergeRequestSeen(ctx, req.(*MarkMergeRequestSeenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MergeRequests_SnoozeMergeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnoozeMergeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MergeRequestsServer).SnoozeMergeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MergeRequests_SnoozeMergeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MergeRequestsServer).SnoozeMergeRequest(ctx, req.(*SnoozeMergeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MergeRequests_PinMergeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMergeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MergeRequestsServer).PinMergeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MergeRequests_PinMergeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MergeRequestsServer).PinMergeRequest(ctx, req.(*PinMergeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MergeRequests_SetPrivateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrivateNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MergeRequestsServer).SetPrivateNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MergeRequests_SetPrivateNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MergeRequestsServer).SetPrivateNote(ctx, req.(*SetPrivateNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MergeRequests_SetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MergeRequestsServer).SetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MergeRequests_SetTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MergeRequestsServer).SetTags(ctx, req.(*SetTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MergeRequests_AssignReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignReviewerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MergeRequestsServer).AssignReviewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MergeRequests_AssignReviewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MergeRequestsServer).AssignReviewer(ctx, req.(*AssignReviewerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MergeRequests_GetDiscussions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiscussionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MergeRequestsServer).GetDiscussions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MergeRequests_GetDiscussions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MergeRequestsServer).GetDiscussions(ctx, req.(*GetDiscussionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MergeRequests_AddComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MergeRequestsServer).AddComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MergeRequests_AddComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MergeRequestsServer).AddComment(ctx, req.(*AddCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MergeRequests_ReplyToDiscussion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyToDiscussionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MergeRequestsServer).ReplyToDiscussion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MergeRequests_ReplyToDiscussion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MergeRequestsServer).ReplyToDiscussion(ctx, req.(*ReplyToDiscussionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MergeRequests_ResolveDiscussion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveDiscussionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MergeRequestsServer).ResolveDiscussion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MergeRequests_ResolveDiscussion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MergeRequestsServer).ResolveDiscussion(ctx, req.(*ResolveDiscussionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MergeRequests_GetAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MergeRequestsServer).GetAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MergeRequests_GetAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MergeRequestsServer).GetAnalytics(ctx, req.(*GetAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MergeRequests_GetMergeRequestTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMergeRequestTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MergeRequestsServer).GetMergeRequestTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MergeRequests_GetMergeRequestTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MergeRequestsServer).GetMergeRequestTimeline(ctx, req.(*GetMergeRequestTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MergeRequests_ServiceDesc is the grpc.ServiceDesc for MergeRequests service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,7 +797,85 @@ var MergeRequests_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMergeRequests",
 			Handler:    _MergeRequests_GetMergeRequests_Handler,
 		},
+		{
+			MethodName: "ApproveMergeRequest",
+			Handler:    _MergeRequests_ApproveMergeRequest_Handler,
+		},
+		{
+			MethodName: "UnapproveMergeRequest",
+			Handler:    _MergeRequests_UnapproveMergeRequest_Handler,
+		},
+		{
+			MethodName: "MergeMergeRequest",
+			Handler:    _MergeRequests_MergeMergeRequest_Handler,
+		},
+		{
+			MethodName: "RebaseMergeRequest",
+			Handler:    _MergeRequests_RebaseMergeRequest_Handler,
+		},
+		{
+			MethodName: "RetryPipeline",
+			Handler:    _MergeRequests_RetryPipeline_Handler,
+		},
+		{
+			MethodName: "RetryJob",
+			Handler:    _MergeRequests_RetryJob_Handler,
+		},
+		{
+			MethodName: "MarkMergeRequestSeen",
+			Handler:    _MergeRequests_MarkMergeRequestSeen_Handler,
+		},
+		{
+			MethodName: "SnoozeMergeRequest",
+			Handler:    _MergeRequests_SnoozeMergeRequest_Handler,
+		},
+		{
+			MethodName: "PinMergeRequest",
+			Handler:    _MergeRequests_PinMergeRequest_Handler,
+		},
+		{
+			MethodName: "SetPrivateNote",
+			Handler:    _MergeRequests_SetPrivateNote_Handler,
+		},
+		{
+			MethodName: "SetTags",
+			Handler:    _MergeRequests_SetTags_Handler,
+		},
+		{
+			MethodName: "AssignReviewer",
+			Handler:    _MergeRequests_AssignReviewer_Handler,
+		},
+		{
+			MethodName: "GetDiscussions",
+			Handler:    _MergeRequests_GetDiscussions_Handler,
+		},
+		{
+			MethodName: "AddComment",
+			Handler:    _MergeRequests_AddComment_Handler,
+		},
+		{
+			MethodName: "ReplyToDiscussion",
+			Handler:    _MergeRequests_ReplyToDiscussion_Handler,
+		},
+		{
+			MethodName: "ResolveDiscussion",
+			Handler:    _MergeRequests_ResolveDiscussion_Handler,
+		},
+		{
+			MethodName: "GetAnalytics",
+			Handler:    _MergeRequests_GetAnalytics_Handler,
+		},
+		{
+			MethodName: "GetMergeRequestTimeline",
+			Handler:    _MergeRequests_GetMergeRequestTimeline_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchMergeRequests",
+			Handler:       _MergeRequests_WatchMergeRequests_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "mr/v1/mr.proto",
 }
@@ -0,0 +1,137 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultCacheTTL = 10 * time.Minute
+
+	etagHeader         = "ETag"
+	ifNoneMatchHeader  = "If-None-Match"
+	cacheControlHeader = "Cache-Control"
+)
+
+var maxAgeRx = regexp.MustCompile(`max-age=(\d+)`)
+
+type cacheEntry struct {
+	data      []byte
+	header    http.Header
+	etag      string
+	expiresAt time.Time
+}
+
+// responseCache keeps responses of rarely changing REST resources; entries are served as is until they expire,
+// then they are revalidated with If-None-Match, so unchanged resources cost a cheap 304 response
+type responseCache struct {
+	mx      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cacheEntry
+}
+
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+func (c *responseCache) setTTL(ttl time.Duration) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.ttl = ttl
+}
+
+// clear drops all entries, i.e. when they were fetched from another gitlab instance or with another token
+func (c *responseCache) clear() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.entries = make(map[string]cacheEntry)
+}
+
+func (c *responseCache) getTTL() time.Duration {
+	if c.ttl > 0 {
+		return c.ttl
+	}
+	return defaultCacheTTL
+}
+
+func (c *responseCache) get(url string) (cacheEntry, bool) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	e, found := c.entries[url]
+	return e, found
+}
+
+func (c *responseCache) put(url string, data []byte, header http.Header, now time.Time) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.evictExpired(now)
+
+	cacheControl := header.Get(cacheControlHeader)
+	if strings.Contains(cacheControl, "no-store") {
+		delete(c.entries, url)
+		return
+	}
+
+	lifetime := c.getTTL()
+	if m := maxAgeRx.FindStringSubmatch(cacheControl); len(m) == 2 {
+		if maxAge, err := strconv.Atoi(m[1]); err == nil && time.Duration(maxAge)*time.Second > lifetime {
+			lifetime = time.Duration(maxAge) * time.Second
+		}
+	}
+
+	c.entries[url] = cacheEntry{
+		data:      data,
+		header:    header,
+		etag:      header.Get(etagHeader),
+		expiresAt: now.Add(lifetime),
+	}
+}
+
+// evictExpired drops entries which were not revalidated during one more TTL after expiration
+func (c *responseCache) evictExpired(now time.Time) {
+	for url, e := range c.entries {
+		if now.Sub(e.expiresAt) > c.getTTL() {
+			delete(c.entries, url)
+		}
+	}
+}
+
+// getCached makes GET request through response cache
+func (c *client) getCached(ctx context.Context, url string) ([]byte, http.Header, error) {
+	now := time.Now()
+	entry, found := c.cache.get(url)
+	if found && now.Before(entry.expiresAt) {
+		return entry.data, entry.header, nil
+	}
+
+	headers := map[string]string{
+		tokenHeader: c.getSettings().Token,
+	}
+	if found && len(entry.etag) > 0 {
+		headers[ifNoneMatchHeader] = entry.etag
+	}
+
+	data, header, err := c.http.GETWithHeaders(ctx, url, headers)
+	if err != nil {
+		if found && ErrorStatusCode(err) == http.StatusNotModified {
+			if len(header.Get(etagHeader)) == 0 {
+				header.Set(etagHeader, entry.etag)
+			}
+			c.cache.put(url, entry.data, header, now)
+			return entry.data, entry.header, nil
+		}
+		return nil, nil, err
+	}
+
+	c.cache.put(url, data, header, now)
+	return data, header, nil
+}
@@ -0,0 +1,169 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/vlanse/glmr/internal/util/request"
+)
+
+func (c *client) approveMergeRequest(ctx context.Context, projectID, mrIID int64) error {
+	return c.sendMergeRequestAction(ctx, http.MethodPost, projectID, mrIID, "approve")
+}
+
+func (c *client) unapproveMergeRequest(ctx context.Context, projectID, mrIID int64) error {
+	return c.sendMergeRequestAction(ctx, http.MethodPost, projectID, mrIID, "unapprove")
+}
+
+func (c *client) mergeMergeRequest(ctx context.Context, projectID, mrIID int64, options MergeOptions) error {
+	return c.sendMergeRequestAction(ctx, http.MethodPut, projectID, mrIID, "merge",
+		"squash", strconv.FormatBool(options.Squash),
+		"should_remove_source_branch", strconv.FormatBool(options.RemoveSourceBranch),
+		"merge_when_pipeline_succeeds", strconv.FormatBool(options.WhenPipelineSucceeds),
+	)
+}
+
+func (c *client) rebaseMergeRequest(ctx context.Context, projectID, mrIID int64) error {
+	return c.sendMergeRequestAction(ctx, http.MethodPut, projectID, mrIID, "rebase")
+}
+
+func (c *client) retryPipeline(ctx context.Context, projectID, pipelineID int64) error {
+	return c.sendRequest(ctx, fmt.Sprintf("retry pipeline %d of project %d", pipelineID, projectID),
+		http.MethodPost, fmt.Sprintf("projects/%d/pipelines/%d/retry", projectID, pipelineID), nil, nil,
+	)
+}
+
+func (c *client) retryJob(ctx context.Context, projectID, jobID int64) error {
+	return c.sendRequest(ctx, fmt.Sprintf("retry job %d of project %d", jobID, projectID),
+		http.MethodPost, fmt.Sprintf("projects/%d/jobs/%d/retry", projectID, jobID), nil, nil,
+	)
+}
+
+func (c *client) getMergeRequestDiscussion(
+	ctx context.Context, projectID, mrIID int64, discussionID string,
+) (Discussion, error) {
+	data, err := c.http.GET(
+		ctx,
+		request.MustURL(fmt.Sprintf("%s/api/v4/projects/%d/merge_requests/%d/discussions/%s",
+			c.getSettings().URL, projectID, mrIID, url.PathEscape(discussionID),
+		)),
+		map[string]string{
+			tokenHeader: c.getSettings().Token,
+		},
+	)
+	if err != nil {
+		return Discussion{}, fmt.Errorf("failed to get merge request discussion from gitlab: %w", err)
+	}
+
+	var res Discussion
+	if err = json.Unmarshal(data, &res); err != nil {
+		return Discussion{}, fmt.Errorf("failed to unmarshal merge request discussion: %w", err)
+	}
+	return res, nil
+}
+
+func (c *client) createMergeRequestDiscussion(
+	ctx context.Context, projectID, mrIID int64, body string,
+) (Discussion, error) {
+	var res Discussion
+	err := c.sendMergeRequestRequest(ctx, "comment", http.MethodPost, projectID, mrIID,
+		"discussions", noteBody{Body: body}, &res,
+	)
+	return res, err
+}
+
+func (c *client) replyToMergeRequestDiscussion(
+	ctx context.Context, projectID, mrIID int64, discussionID string, body string,
+) (Note, error) {
+	var res Note
+	err := c.sendMergeRequestRequest(ctx, "reply to discussion of", http.MethodPost, projectID, mrIID,
+		fmt.Sprintf("discussions/%s/notes", url.PathEscape(discussionID)), noteBody{Body: body}, &res,
+	)
+	return res, err
+}
+
+func (c *client) resolveMergeRequestDiscussion(
+	ctx context.Context, projectID, mrIID int64, discussionID string, resolved bool,
+) (Discussion, error) {
+	var res Discussion
+	action := "resolve discussion of"
+	if !resolved {
+		action = "unresolve discussion of"
+	}
+	err := c.sendMergeRequestRequest(ctx, action, http.MethodPut, projectID, mrIID,
+		fmt.Sprintf("discussions/%s", url.PathEscape(discussionID)), nil, &res,
+		"resolved", strconv.FormatBool(resolved),
+	)
+	return res, err
+}
+
+func (c *client) setMergeRequestReviewers(ctx context.Context, projectID, mrIID int64, reviewerIDs []int64) error {
+	return c.sendRequest(ctx, fmt.Sprintf("set reviewers of merge request %d of project %d", mrIID, projectID),
+		http.MethodPut, fmt.Sprintf("projects/%d/merge_requests/%d", projectID, mrIID),
+		reviewersBody{ReviewerIDs: reviewerIDs}, nil,
+	)
+}
+
+type reviewersBody struct {
+	ReviewerIDs []int64 `json:"reviewer_ids"`
+}
+
+type noteBody struct {
+	Body string `json:"body"`
+}
+
+func (c *client) sendMergeRequestAction(
+	ctx context.Context, method string, projectID, mrIID int64, action string, queryKV ...string,
+) error {
+	return c.sendMergeRequestRequest(ctx, action, method, projectID, mrIID, action, nil, nil, queryKV...)
+}
+
+// sendMergeRequestRequest makes request with side effects to merge request subresource,
+// action describes request in error message
+func (c *client) sendMergeRequestRequest(
+	ctx context.Context, action, method string, projectID, mrIID int64, path string, reqBody, res any,
+	queryKV ...string,
+) error {
+	return c.sendRequest(ctx, fmt.Sprintf("%s merge request %d of project %d", action, mrIID, projectID),
+		method, fmt.Sprintf("projects/%d/merge_requests/%d/%s", projectID, mrIID, path), reqBody, res, queryKV...,
+	)
+}
+
+// sendRequest makes request with side effects to REST API path, reqBody and res are marshaled as JSON when set
+func (c *client) sendRequest(
+	ctx context.Context, action, method string, path string, reqBody, res any, queryKV ...string,
+) error {
+	headers := map[string]string{
+		tokenHeader: c.getSettings().Token,
+	}
+	var reqData []byte
+	if reqBody != nil {
+		var err error
+		if reqData, err = json.Marshal(reqBody); err != nil {
+			return fmt.Errorf("failed to marshal request to %s: %w", action, err)
+		}
+		headers["Content-Type"] = "application/json"
+	}
+
+	data, err := c.http.Send(
+		ctx,
+		method,
+		request.MustURL(fmt.Sprintf("%s/api/v4/%s", c.getSettings().URL, path), queryKV...),
+		headers,
+		reqData,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to %s: %w", action, withResponseMessage(data, err))
+	}
+
+	if res != nil {
+		if err = json.Unmarshal(data, res); err != nil {
+			return fmt.Errorf("failed to unmarshal response to %s: %w", action, err)
+		}
+	}
+	return nil
+}
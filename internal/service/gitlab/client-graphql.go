@@ -0,0 +1,437 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/vlanse/glmr/internal/util/request"
+)
+
+const (
+	defaultBatchSizeGQ = 5
+	projectGIDPrefix   = "gid://gitlab/Project/"
+	// minPageSizeGQ is the smallest page of merge requests requested before the heaviest fields are dropped
+	minPageSizeGQ = 10
+	// heavyFieldsRetryInterval is how long fields dropped because of query complexity are not requested
+	heavyFieldsRetryInterval = time.Hour
+)
+
+var (
+	unknownFieldRx = regexp.MustCompile(`Field '(\w+)' doesn't exist on type '(\w+)'`)
+	complexityRx   = regexp.MustCompile(`(?i)complexity`)
+)
+
+type errorGQ struct {
+	Message string `json:"message"`
+	Path    []any  `json:"path"`
+}
+
+type errorsGQ []errorGQ
+
+func (e errorsGQ) Error() string {
+	return strings.Join(lo.Map(e, func(item errorGQ, _ int) string { return item.Message }), "; ")
+}
+
+// byAlias groups errors by top level field (alias) of the query they belong to;
+// returns false when some error is not bound to any field, i.e. query is invalid at all
+func (e errorsGQ) byAlias() (map[string]errorsGQ, bool) {
+	res := make(map[string]errorsGQ)
+	for _, item := range e {
+		if len(item.Path) == 0 {
+			return nil, false
+		}
+		alias, ok := item.Path[0].(string)
+		if !ok {
+			return nil, false
+		}
+		res[alias] = append(res[alias], item)
+	}
+	return res, true
+}
+
+type projectMRsGQ struct {
+	ProjectGQ
+	MergeRequests struct {
+		Nodes    []MergeRequestGQ `json:"nodes"`
+		PageInfo pageInfoGQ       `json:"pageInfo"`
+	} `json:"mergeRequests"`
+}
+
+// queryGQ executes GraphQL query and unmarshals its data into res, errors reported by gitlab are returned as errorsGQ
+func (c *client) queryGQ(ctx context.Context, query string, res any) error {
+	q := struct {
+		Query string `json:"query"`
+	}{
+		Query: query,
+	}
+	reqData, err := json.Marshal(q)
+	if err != nil {
+		return fmt.Errorf("failed to marshal graphql query: %w", err)
+	}
+
+	data, err := c.http.POST(ctx,
+		request.MustURL(fmt.Sprintf("%s/api/graphql", c.getSettings().URL)),
+		map[string]string{
+			authHeader:     fmt.Sprintf("Bearer %s", c.getSettings().Token),
+			"Content-Type": "application/json",
+		},
+		reqData,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to execute graphql query: %w", err)
+	}
+
+	resp := struct {
+		Data   json.RawMessage `json:"data"`
+		Errors errorsGQ        `json:"errors"`
+	}{}
+	if err = json.Unmarshal(data, &resp); err != nil {
+		return fmt.Errorf("failed to unmarshal graphql response: %w", err)
+	}
+
+	if len(resp.Data) > 0 && string(resp.Data) != "null" {
+		if err = json.Unmarshal(resp.Data, res); err != nil {
+			return fmt.Errorf("failed to unmarshal graphql response data: %w", err)
+		}
+	}
+
+	if len(resp.Errors) > 0 {
+		return resp.Errors
+	}
+	return nil
+}
+
+// getProjectsMergeRequestsGQ fetches metadata and opened merge requests of many projects using aliased queries,
+// projects are split into chunks so that every query stays within gitlab query complexity limits;
+// failure of one chunk does not affect others, errors are returned per project path
+func (c *client) getProjectsMergeRequestsGQ(
+	ctx context.Context, projectPaths []string,
+) (map[string]ProjectGQ, map[string]error) {
+	res := make(map[string]ProjectGQ, len(projectPaths))
+	errs := make(map[string]error)
+	for _, chunk := range lo.Chunk(projectPaths, c.getBatchSizeGQ()) {
+		c.fetchProjectsChunkGQ(ctx, chunk, pageSize, res, errs)
+	}
+	return res, errs
+}
+
+// fetchProjectsChunkGQ requests first merge requests per page, when query is too complex, chunk is split first,
+// then page is reduced and only then the heaviest optional fields are dropped
+func (c *client) fetchProjectsChunkGQ(
+	ctx context.Context, projectPaths []string, first int, res map[string]ProjectGQ, resErrs map[string]error,
+) {
+	// project path -> cursor of the next page, projects without further pages are removed
+	cursors := lo.SliceToMap(projectPaths, func(item string) (string, string) {
+		return item, ""
+	})
+
+	for page := 0; len(cursors) > 0; page++ {
+		if page >= c.getMaxPages() {
+			log.Printf("gitlab: projects %v have more than %d pages of merge requests, the rest is skipped",
+				lo.Keys(cursors), c.getMaxPages(),
+			)
+			break
+		}
+
+		pending := lo.Keys(cursors)
+		slices.Sort(pending)
+
+		data := make(map[string]*projectMRsGQ, len(pending))
+		var aliasErrs map[string]errorsGQ
+		if err := c.queryGQ(ctx, c.buildProjectsMRsQuery(pending, cursors, first), &data); err != nil {
+			var (
+				errs    errorsGQ
+				partial bool
+			)
+			if errors.As(err, &errs) {
+				if page == 0 && complexityRx.MatchString(errs.Error()) {
+					if len(pending) > 1 {
+						half := len(pending) / 2
+						c.fetchProjectsChunkGQ(ctx, pending[:half], first, res, resErrs)
+						c.fetchProjectsChunkGQ(ctx, pending[half:], first, res, resErrs)
+						return
+					}
+					if first > minPageSizeGQ {
+						first /= 2
+						page--
+						continue
+					}
+					// even single project query is too complex, the heaviest optional fields are left to REST API
+					if c.dropHeaviestFieldsGQ() {
+						page--
+						continue
+					}
+				}
+
+				if c.dropUnsupportedFieldsGQ(errs) {
+					page--
+					continue
+				}
+
+				aliasErrs, partial = errs.byAlias()
+			}
+
+			if !partial {
+				for _, path := range pending {
+					resErrs[path] = fmt.Errorf("failed to get project merge requests from gitlab: %w", err)
+					delete(res, path)
+				}
+				return
+			}
+		}
+
+		for i, path := range pending {
+			if errs, found := aliasErrs[projectAlias(i)]; found {
+				resErrs[path] = fmt.Errorf("failed to get project merge requests from gitlab: %w", errs)
+				delete(res, path)
+				delete(cursors, path)
+				continue
+			}
+
+			p := data[projectAlias(i)]
+			if p == nil {
+				resErrs[path] = fmt.Errorf("project %s: %w", path, ErrNotFound)
+				delete(cursors, path)
+				continue
+			}
+
+			project := res[path]
+			mrs := project.MergeRequests
+			project = p.ProjectGQ
+			project.MergeRequests = append(mrs, p.MergeRequests.Nodes...)
+			res[path] = project
+
+			if p.MergeRequests.PageInfo.HasNextPage && len(p.MergeRequests.PageInfo.EndCursor) > 0 {
+				cursors[path] = p.MergeRequests.PageInfo.EndCursor
+			} else {
+				delete(cursors, path)
+			}
+		}
+	}
+}
+
+// getProjectPathsGQ resolves full paths of projects by their IDs, projects which do not exist
+// or are not accessible are missing in result
+func (c *client) getProjectPathsGQ(ctx context.Context, projectIDs []int64) (map[int64]string, error) {
+	res := make(map[int64]string, len(projectIDs))
+	for _, chunk := range lo.Chunk(projectIDs, pageSize) {
+		gids := lo.Map(chunk, func(item int64, _ int) string {
+			return strconv.Quote(fmt.Sprintf("%s%d", projectGIDPrefix, item))
+		})
+
+		data := struct {
+			Projects struct {
+				Nodes []ProjectGQ `json:"nodes"`
+			} `json:"projects"`
+		}{}
+		if err := c.queryGQ(ctx, fmt.Sprintf(projectPathsRequest, strings.Join(gids, ", "), len(chunk)), &data); err != nil {
+			return nil, fmt.Errorf("failed to get project paths from gitlab: %w", err)
+		}
+
+		for _, p := range data.Projects.Nodes {
+			id, err := strconv.ParseInt(strings.TrimPrefix(p.ID, projectGIDPrefix), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get project paths from gitlab: unexpected project id %q", p.ID)
+			}
+			res[id] = p.FullPath
+		}
+	}
+	return res, nil
+}
+
+func (c *client) getMergeRequestGQ(ctx context.Context, projectFullPath string, mergeRequestIID int64) (MergeRequestGQ, error) {
+	for {
+		data := struct {
+			Project *struct {
+				MergeRequest *MergeRequestGQ `json:"mergeRequest"`
+			} `json:"project"`
+		}{}
+
+		query := fmt.Sprintf(projectMRRequest, projectFullPath, mergeRequestIID) + c.mergeRequestFragment() + userFragment
+		if err := c.queryGQ(ctx, query, &data); err != nil {
+			var errs errorsGQ
+			if errors.As(err, &errs) && c.dropUnsupportedFieldsGQ(errs) {
+				continue
+			}
+			return MergeRequestGQ{}, fmt.Errorf("failed to get merge request from gitlab: %w", err)
+		}
+
+		if data.Project == nil || data.Project.MergeRequest == nil {
+			return MergeRequestGQ{}, fmt.Errorf("merge request %s!%d: %w", projectFullPath, mergeRequestIID, ErrNotFound)
+		}
+		return *data.Project.MergeRequest, nil
+	}
+}
+
+// getCurrentUserMergeRequestsGQ fetches opened merge requests related to the current user across all projects
+func (c *client) getCurrentUserMergeRequestsGQ(
+	ctx context.Context, connection CurrentUserMergeRequests,
+) ([]MergeRequestGQ, error) {
+	var (
+		res    []MergeRequestGQ
+		cursor string
+		first  = pageSize
+	)
+	for page := 0; ; page++ {
+		if page >= c.getMaxPages() {
+			log.Printf("gitlab: %s of current user have more than %d pages, the rest is skipped",
+				connection, c.getMaxPages(),
+			)
+			return res, nil
+		}
+
+		after := "null"
+		if len(cursor) > 0 {
+			after = strconv.Quote(cursor)
+		}
+
+		data := struct {
+			CurrentUser *struct {
+				MergeRequests struct {
+					Nodes    []MergeRequestGQ `json:"nodes"`
+					PageInfo pageInfoGQ       `json:"pageInfo"`
+				} `json:"mergeRequests"`
+			} `json:"currentUser"`
+		}{}
+
+		query := fmt.Sprintf(currentUserMRsRequest, connection, first, after) + c.mergeRequestFragment() + userFragment
+		if err := c.queryGQ(ctx, query, &data); err != nil {
+			var errs errorsGQ
+			if errors.As(err, &errs) {
+				if page == 0 && complexityRx.MatchString(errs.Error()) {
+					if first > minPageSizeGQ {
+						first /= 2
+						page--
+						continue
+					}
+					if c.dropHeaviestFieldsGQ() {
+						page--
+						continue
+					}
+				}
+				if c.dropUnsupportedFieldsGQ(errs) {
+					page--
+					continue
+				}
+			}
+			return nil, fmt.Errorf("failed to get %s of current user from gitlab: %w", connection, err)
+		}
+
+		if data.CurrentUser == nil {
+			return nil, fmt.Errorf("failed to get %s: current user is not authenticated", connection)
+		}
+
+		res = append(res, data.CurrentUser.MergeRequests.Nodes...)
+
+		pageInfo := data.CurrentUser.MergeRequests.PageInfo
+		if !pageInfo.HasNextPage || len(pageInfo.EndCursor) == 0 {
+			return res, nil
+		}
+		cursor = pageInfo.EndCursor
+	}
+}
+
+func (c *client) buildProjectsMRsQuery(projectPaths []string, cursors map[string]string, first int) string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	for i, path := range projectPaths {
+		after := "null"
+		if cursor := cursors[path]; len(cursor) > 0 {
+			after = strconv.Quote(cursor)
+		}
+		sb.WriteString(fmt.Sprintf(projectMRsRequestPart, projectAlias(i), path, first, after))
+	}
+	sb.WriteString("}")
+	sb.WriteString(c.mergeRequestFragment())
+	sb.WriteString(userFragment)
+	return sb.String()
+}
+
+func (c *client) mergeRequestFragment() string {
+	c.unsupportedMxGQ.Lock()
+	defer c.unsupportedMxGQ.Unlock()
+
+	var optional strings.Builder
+	for _, f := range optionalMergeRequestFieldsGQ {
+		if !c.isDroppedGQ(f.name) {
+			optional.WriteString(f.fields)
+		}
+	}
+	return fmt.Sprintf(mergeRequestFragment, optional.String())
+}
+
+// isDroppedGQ reports whether optional fields are not requested, must be called with unsupportedMxGQ held
+func (c *client) isDroppedGQ(name string) bool {
+	if c.unsupportedGQ[name] {
+		return true
+	}
+	droppedAt, found := c.heavyDroppedGQ[name]
+	return found && time.Since(droppedAt) < heavyFieldsRetryInterval
+}
+
+// resetFieldsGQ makes all optional fields requested again, i.e. when gitlab instance may have changed
+func (c *client) resetFieldsGQ() {
+	c.unsupportedMxGQ.Lock()
+	defer c.unsupportedMxGQ.Unlock()
+	c.unsupportedGQ = make(map[string]bool)
+	c.heavyDroppedGQ = make(map[string]time.Time)
+}
+
+// dropUnsupportedFieldsGQ remembers optional fields unknown to gitlab instance, so they are not requested anymore;
+// returns true when query should be retried
+func (c *client) dropUnsupportedFieldsGQ(errs errorsGQ) bool {
+	c.unsupportedMxGQ.Lock()
+	defer c.unsupportedMxGQ.Unlock()
+
+	var dropped bool
+	for _, e := range errs {
+		m := unknownFieldRx.FindStringSubmatch(e.Message)
+		if len(m) != 3 {
+			continue
+		}
+		field := m[2] + "." + m[1]
+		for _, f := range optionalMergeRequestFieldsGQ {
+			if !c.unsupportedGQ[f.name] && slices.Contains(f.declared, field) {
+				c.unsupportedGQ[f.name] = true
+				dropped = true
+			}
+		}
+	}
+	return dropped
+}
+
+// dropHeaviestFieldsGQ stops requesting the heaviest optional fields for heavyFieldsRetryInterval,
+// returns false when there is nothing to drop
+func (c *client) dropHeaviestFieldsGQ() bool {
+	c.unsupportedMxGQ.Lock()
+	defer c.unsupportedMxGQ.Unlock()
+
+	for i := len(optionalMergeRequestFieldsGQ) - 1; i >= 0; i-- {
+		if f := optionalMergeRequestFieldsGQ[i]; !c.isDroppedGQ(f.name) {
+			c.heavyDroppedGQ[f.name] = time.Now()
+			log.Printf("gitlab: query is too complex, %s are fetched with REST API for %s", f.name, heavyFieldsRetryInterval)
+			return true
+		}
+	}
+	return false
+}
+
+func (c *client) getBatchSizeGQ() int {
+	if batchSize := c.getSettings().GraphQLBatchSize; batchSize > 0 {
+		return batchSize
+	}
+	return defaultBatchSizeGQ
+}
+
+func projectAlias(idx int) string {
+	return fmt.Sprintf("p%d", idx)
+}
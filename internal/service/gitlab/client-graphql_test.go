@@ -0,0 +1,401 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/vlanse/glmr/internal/util/request"
+)
+
+// projectQueryGQ is a part of batched query which requests merge requests of single project
+type projectQueryGQ struct {
+	alias string
+	path  string
+	first int
+	after string // cursor, empty for the first page
+}
+
+var projectQueryRx = regexp.MustCompile(`(?s)(p\d+): project\(fullPath: "([^"]+)"\).*?first: (\d+), after: (null|"[^"]*")`)
+
+func parseProjectsQuery(query string) []projectQueryGQ {
+	return lo.Map(projectQueryRx.FindAllStringSubmatch(query, -1), func(m []string, _ int) projectQueryGQ {
+		first, _ := strconv.Atoi(m[3])
+		after, _ := strconv.Unquote(m[4])
+		return projectQueryGQ{alias: m[1], path: m[2], first: first, after: after}
+	})
+}
+
+// fakeServerGQ is gitlab GraphQL endpoint which records received queries
+type fakeServerGQ struct {
+	*httptest.Server
+	mx      sync.Mutex
+	queries []string
+}
+
+func newFakeServerGQ(t *testing.T, respond func(w http.ResponseWriter, query string, projects []projectQueryGQ)) *fakeServerGQ {
+	t.Helper()
+
+	s := &fakeServerGQ{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query string `json:"query"`
+		}
+		if r.URL.Path != "/api/graphql" || json.NewDecoder(r.Body).Decode(&req) != nil {
+			http.NotFound(w, r)
+			return
+		}
+		s.mx.Lock()
+		s.queries = append(s.queries, req.Query)
+		s.mx.Unlock()
+		respond(w, req.Query, parseProjectsQuery(req.Query))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// projectsOfQueries returns paths of projects requested by every received query
+func (s *fakeServerGQ) projectsOfQueries() [][]string {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	return lo.Map(s.queries, func(query string, _ int) []string {
+		return lo.Map(parseProjectsQuery(query), func(p projectQueryGQ, _ int) string { return p.path })
+	})
+}
+
+func writeResponseGQ(w http.ResponseWriter, data map[string]any, errs ...errorGQ) {
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": data, "errors": errs})
+}
+
+// projectDataGQ is project with merge requests of given IIDs, cursor is set when there are more pages
+func projectDataGQ(path string, cursor string, iids ...int64) map[string]any {
+	return map[string]any{
+		"id":       "gid://gitlab/Project/1",
+		"fullPath": path,
+		"name":     path[strings.LastIndex(path, "/")+1:],
+		"mergeRequests": map[string]any{
+			"pageInfo": map[string]any{"hasNextPage": len(cursor) > 0, "endCursor": cursor},
+			"nodes": lo.Map(iids, func(iid int64, _ int) map[string]any {
+				return map[string]any{"iid": strconv.FormatInt(iid, 10)}
+			}),
+		},
+	}
+}
+
+func newTestServiceGQ(url string, batchSize int) *Service {
+	return NewService(Settings{URL: url, GraphQLBatchSize: batchSize, HTTP: request.Settings{MaxRetries: -1}})
+}
+
+func mergeRequestIIDs(projects map[string]ProjectGQ) map[string][]int64 {
+	return lo.MapValues(projects, func(p ProjectGQ, _ string) []int64 {
+		return lo.Map(p.MergeRequests, func(mr MergeRequestGQ, _ int) int64 { return mr.IID })
+	})
+}
+
+func TestGetProjectsMergeRequestsGQ(t *testing.T) {
+	paths := []string{"g/a", "g/b", "g/c"}
+	mrsByPath := map[string][]int64{"g/a": {1}, "g/b": {2, 3}, "g/c": {4}}
+
+	// respond returns merge requests of known projects, failing projects get error bound to their alias
+	respond := func(failing ...string) func(http.ResponseWriter, string, []projectQueryGQ) {
+		return func(w http.ResponseWriter, _ string, projects []projectQueryGQ) {
+			data := make(map[string]any)
+			var errs []errorGQ
+			for _, p := range projects {
+				iids, found := mrsByPath[p.path]
+				switch {
+				case slices.Contains(failing, p.path):
+					data[p.alias] = nil
+					errs = append(errs, errorGQ{Message: "access denied", Path: []any{p.alias, "mergeRequests"}})
+				case found:
+					data[p.alias] = projectDataGQ(p.path, "", iids...)
+				default:
+					data[p.alias] = nil
+				}
+			}
+			writeResponseGQ(w, data, errs...)
+		}
+	}
+
+	tests := []struct {
+		name         string
+		paths        []string
+		batchSize    int
+		respond      func(w http.ResponseWriter, query string, projects []projectQueryGQ)
+		wantQueries  [][]string
+		wantMRs      map[string][]int64
+		wantErrs     []string // paths of projects which failed
+		wantNotFound []string
+	}{
+		{
+			name:        "aliases are mapped to projects",
+			paths:       paths,
+			respond:     respond(),
+			wantQueries: [][]string{paths},
+			wantMRs:     mrsByPath,
+		},
+		{
+			name:        "projects are split into chunks by batch size",
+			paths:       paths,
+			batchSize:   2,
+			respond:     respond(),
+			wantQueries: [][]string{{"g/a", "g/b"}, {"g/c"}},
+			wantMRs:     mrsByPath,
+		},
+		{
+			name:        "error of single project does not affect others",
+			paths:       paths,
+			respond:     respond("g/b"),
+			wantQueries: [][]string{paths},
+			wantMRs:     map[string][]int64{"g/a": {1}, "g/c": {4}},
+			wantErrs:    []string{"g/b"},
+		},
+		{
+			name:         "project which does not exist",
+			paths:        []string{"g/a", "g/missing"},
+			respond:      respond(),
+			wantQueries:  [][]string{{"g/a", "g/missing"}},
+			wantMRs:      map[string][]int64{"g/a": {1}},
+			wantErrs:     []string{"g/missing"},
+			wantNotFound: []string{"g/missing"},
+		},
+		{
+			name:  "error of whole query fails all projects of chunk",
+			paths: paths,
+			respond: func(w http.ResponseWriter, _ string, _ []projectQueryGQ) {
+				writeResponseGQ(w, nil, errorGQ{Message: "syntax error"})
+			},
+			wantQueries: [][]string{paths},
+			wantMRs:     map[string][]int64{},
+			wantErrs:    paths,
+		},
+		{
+			name:      "failed request fails projects of its chunk only",
+			paths:     paths,
+			batchSize: 2,
+			respond: func(w http.ResponseWriter, query string, projects []projectQueryGQ) {
+				if len(projects) > 1 {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				respond()(w, query, projects)
+			},
+			wantQueries: [][]string{{"g/a", "g/b"}, {"g/c"}},
+			wantMRs:     map[string][]int64{"g/c": {4}},
+			wantErrs:    []string{"g/a", "g/b"},
+		},
+		{
+			name:  "chunk is split in halves when query is too complex",
+			paths: paths,
+			respond: func(w http.ResponseWriter, query string, projects []projectQueryGQ) {
+				if len(projects) > 1 {
+					writeResponseGQ(w, nil, errorGQ{Message: "Query has complexity of 3000, which exceeds max complexity of 250"})
+					return
+				}
+				respond()(w, query, projects)
+			},
+			wantQueries: [][]string{paths, {"g/a"}, {"g/b", "g/c"}, {"g/b"}, {"g/c"}},
+			wantMRs:     mrsByPath,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newFakeServerGQ(t, tt.respond)
+			svc := newTestServiceGQ(srv.URL, tt.batchSize)
+
+			projects, errs := svc.GetProjectsMergeRequestsGQ(context.Background(), tt.paths)
+
+			if got := srv.projectsOfQueries(); !slices.EqualFunc(got, tt.wantQueries, slices.Equal) {
+				t.Errorf("queried projects = %v, want %v", got, tt.wantQueries)
+			}
+			if got := mergeRequestIIDs(projects); !maps.EqualFunc(got, tt.wantMRs, slices.Equal) {
+				t.Errorf("merge requests = %v, want %v", got, tt.wantMRs)
+			}
+			for path, p := range projects {
+				if p.FullPath != path {
+					t.Errorf("project %s has path %s", path, p.FullPath)
+				}
+			}
+			if got := slices.Sorted(maps.Keys(errs)); !slices.Equal(got, tt.wantErrs) {
+				t.Errorf("failed projects = %v, want %v", got, tt.wantErrs)
+			}
+			for _, path := range tt.wantNotFound {
+				if !errors.Is(errs[path], ErrNotFound) {
+					t.Errorf("error of %s = %v, want %v", path, errs[path], ErrNotFound)
+				}
+			}
+		})
+	}
+}
+
+func TestGetProjectsMergeRequestsGQRetries(t *testing.T) {
+	const complexityErr = "Query has complexity of 3000, which exceeds max complexity of 250"
+
+	// respondUnless fails queries matching the condition and returns merge requests of every page otherwise,
+	// project g/paged has two pages
+	respondUnless := func(fail func(query string, projects []projectQueryGQ) string) func(http.ResponseWriter, string, []projectQueryGQ) {
+		return func(w http.ResponseWriter, query string, projects []projectQueryGQ) {
+			if msg := fail(query, projects); len(msg) > 0 {
+				writeResponseGQ(w, nil, errorGQ{Message: msg})
+				return
+			}
+			data := make(map[string]any)
+			for _, p := range projects {
+				switch {
+				case p.path == "g/paged" && len(p.after) == 0:
+					data[p.alias] = projectDataGQ(p.path, "cursor-1", 1, 2)
+				case p.path == "g/paged":
+					data[p.alias] = projectDataGQ(p.path, "", 3)
+				default:
+					data[p.alias] = projectDataGQ(p.path, "", 4)
+				}
+			}
+			writeResponseGQ(w, data)
+		}
+	}
+
+	tests := []struct {
+		name         string
+		paths        []string
+		maxPages     int
+		respond      func(w http.ResponseWriter, query string, projects []projectQueryGQ)
+		wantQueries  []string // projects of queries as path:first:cursor
+		wantMRs      map[string][]int64
+		wantErrs     []string
+		wantFields   []string // fields requested by the last query
+		wantNoFields []string // fields which are not requested by the last query
+	}{
+		{
+			name:  "page is halved when query is too complex",
+			paths: []string{"g/a"},
+			respond: respondUnless(func(_ string, projects []projectQueryGQ) string {
+				return lo.Ternary(projects[0].first > 25, complexityErr, "")
+			}),
+			wantQueries: []string{"g/a:100:", "g/a:50:", "g/a:25:"},
+			wantMRs:     map[string][]int64{"g/a": {4}},
+			wantFields:  []string{"approvalsLeft", "approvalState", "discussions("},
+		},
+		{
+			name:  "the heaviest fields are dropped when the smallest page is too complex",
+			paths: []string{"g/a"},
+			respond: respondUnless(func(query string, _ []projectQueryGQ) string {
+				return lo.Ternary(strings.Contains(query, "discussions("), complexityErr, "")
+			}),
+			wantQueries:  []string{"g/a:100:", "g/a:50:", "g/a:25:", "g/a:12:", "g/a:6:", "g/a:6:"},
+			wantMRs:      map[string][]int64{"g/a": {4}},
+			wantFields:   []string{"approvalsLeft", "approvalState"},
+			wantNoFields: []string{"discussions("},
+		},
+		{
+			name:  "query which is too complex without optional fields fails",
+			paths: []string{"g/a"},
+			respond: respondUnless(func(string, []projectQueryGQ) string {
+				return complexityErr
+			}),
+			wantQueries: []string{
+				"g/a:100:", "g/a:50:", "g/a:25:", "g/a:12:", "g/a:6:", "g/a:6:", "g/a:6:", "g/a:6:",
+			},
+			wantMRs:      map[string][]int64{},
+			wantErrs:     []string{"g/a"},
+			wantNoFields: []string{"approvalsLeft", "approvalState", "discussions("},
+		},
+		{
+			name:  "fields unknown to gitlab are dropped",
+			paths: []string{"g/a"},
+			respond: respondUnless(func(query string, _ []projectQueryGQ) string {
+				if strings.Contains(query, "approvalsLeft") {
+					return "Field 'approvalsLeft' doesn't exist on type 'MergeRequest'"
+				}
+				return ""
+			}),
+			wantQueries:  []string{"g/a:100:", "g/a:100:"},
+			wantMRs:      map[string][]int64{"g/a": {4}},
+			wantFields:   []string{"approvalState", "discussions("},
+			wantNoFields: []string{"approvalsLeft"},
+		},
+		{
+			name:  "unknown fields which are not optional fail query",
+			paths: []string{"g/a"},
+			respond: respondUnless(func(string, []projectQueryGQ) string {
+				return "Field 'webUrl' doesn't exist on type 'Project'"
+			}),
+			wantQueries: []string{"g/a:100:"},
+			wantMRs:     map[string][]int64{},
+			wantErrs:    []string{"g/a"},
+		},
+		{
+			name:        "next pages are requested for projects which have them only",
+			paths:       []string{"g/a", "g/paged"},
+			respond:     respondUnless(func(string, []projectQueryGQ) string { return "" }),
+			wantQueries: []string{"g/a:100:,g/paged:100:", "g/paged:100:cursor-1"},
+			wantMRs:     map[string][]int64{"g/a": {4}, "g/paged": {1, 2, 3}},
+		},
+		{
+			name:        "pages are limited",
+			paths:       []string{"g/paged"},
+			maxPages:    1,
+			respond:     respondUnless(func(string, []projectQueryGQ) string { return "" }),
+			wantQueries: []string{"g/paged:100:"},
+			wantMRs:     map[string][]int64{"g/paged": {1, 2}},
+		},
+		{
+			name:  "page size is kept for next pages",
+			paths: []string{"g/paged"},
+			respond: respondUnless(func(_ string, projects []projectQueryGQ) string {
+				return lo.Ternary(projects[0].first > 50, complexityErr, "")
+			}),
+			wantQueries: []string{"g/paged:100:", "g/paged:50:", "g/paged:50:cursor-1"},
+			wantMRs:     map[string][]int64{"g/paged": {1, 2, 3}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newFakeServerGQ(t, tt.respond)
+			svc := NewService(Settings{URL: srv.URL, MaxPages: tt.maxPages, HTTP: request.Settings{MaxRetries: -1}})
+
+			projects, errs := svc.GetProjectsMergeRequestsGQ(context.Background(), tt.paths)
+
+			srv.mx.Lock()
+			queries := slices.Clone(srv.queries)
+			srv.mx.Unlock()
+			got := lo.Map(queries, func(query string, _ int) string {
+				return strings.Join(lo.Map(parseProjectsQuery(query), func(p projectQueryGQ, _ int) string {
+					return p.path + ":" + strconv.Itoa(p.first) + ":" + p.after
+				}), ",")
+			})
+			if !slices.Equal(got, tt.wantQueries) {
+				t.Errorf("queries = %v, want %v", got, tt.wantQueries)
+			}
+			if got := mergeRequestIIDs(projects); !maps.EqualFunc(got, tt.wantMRs, slices.Equal) {
+				t.Errorf("merge requests = %v, want %v", got, tt.wantMRs)
+			}
+			if got := slices.Sorted(maps.Keys(errs)); !slices.Equal(got, tt.wantErrs) {
+				t.Errorf("failed projects = %v, want %v", got, tt.wantErrs)
+			}
+
+			last := queries[len(queries)-1]
+			for _, field := range tt.wantFields {
+				if !strings.Contains(last, field) {
+					t.Errorf("the last query does not request %s", field)
+				}
+			}
+			for _, field := range tt.wantNoFields {
+				if strings.Contains(last, field) {
+					t.Errorf("the last query requests %s", field)
+				}
+			}
+		})
+	}
+}
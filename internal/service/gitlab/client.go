@@ -4,6 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/vlanse/glmr/internal/util/request"
 )
@@ -14,48 +18,61 @@ const (
 )
 
 type client struct {
-	baseURL string
-	token   string
+	http            *request.Client
+	cache           *responseCache
+	settings        Settings
+	settingsMx      sync.RWMutex
+	unsupportedGQ   map[string]bool      // optional fields unknown to gitlab instance
+	heavyDroppedGQ  map[string]time.Time // optional fields dropped because of query complexity, by drop time
+	unsupportedMxGQ sync.Mutex
 }
 
-func newClient(baseURL string, token string) *client {
+func newClient(settings Settings) *client {
 	return &client{
-		baseURL: baseURL,
-		token:   token,
+		http:           request.NewClient(settings.HTTP),
+		cache:          newResponseCache(settings.CacheTTL),
+		settings:       settings,
+		unsupportedGQ:  make(map[string]bool),
+		heavyDroppedGQ: make(map[string]time.Time),
 	}
 }
 
+// updateSettings applies new settings, responses cached with another gitlab instance or token are dropped
+func (c *client) updateSettings(settings Settings) {
+	c.settingsMx.Lock()
+	defer c.settingsMx.Unlock()
+
+	// cached responses are keyed by URL and may be not visible with another token
+	if settings.URL != c.settings.URL || settings.Token != c.settings.Token {
+		c.cache.clear()
+	}
+	c.settings = settings
+	c.http.UpdateSettings(settings.HTTP)
+	c.cache.setTTL(settings.CacheTTL)
+	c.resetFieldsGQ()
+}
+
+func (c *client) getSettings() Settings {
+	c.settingsMx.RLock()
+	defer c.settingsMx.RUnlock()
+	return c.settings
+}
+
 func (c *client) getProjectMergeRequests(ctx context.Context, projectID int64) ([]MergeRequest, error) {
-	data, err := request.GET(
-		ctx,
-		request.MustURL(
-			fmt.Sprintf("%s/api/v4/projects/%d/merge_requests", c.baseURL, projectID),
-			"per_page", "100", "page", "1", "state", "opened",
-		),
-		map[string]string{
-			tokenHeader: c.token,
-		},
+	res, err := getAllPages[MergeRequest](
+		ctx, c,
+		fmt.Sprintf("%s/api/v4/projects/%d/merge_requests", c.getSettings().URL, projectID),
+		"state", "opened",
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get merge requests from gitlab: %w", err)
 	}
 
-	res := make([]MergeRequest, 0)
-	if err = json.Unmarshal(data, &res); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal merge requests info: %w", err)
-	}
-
 	return res, nil
 }
 
 func (c *client) getProject(ctx context.Context, projectID int64) (Project, error) {
-	data, err := request.GET(
-		ctx,
-		request.MustURL(fmt.Sprintf("%s/api/v4/projects/%d", c.baseURL, projectID)),
-		map[string]string{
-			tokenHeader: c.token,
-		},
-	)
+	data, _, err := c.getCached(ctx, request.MustURL(fmt.Sprintf("%s/api/v4/projects/%d", c.getSettings().URL, projectID)))
 	if err != nil {
 		return Project{}, fmt.Errorf("failed to get project info from gitlab: %w", err)
 	}
@@ -69,32 +86,43 @@ func (c *client) getProject(ctx context.Context, projectID int64) (Project, erro
 
 }
 
-func (c *client) getApprovalRules(ctx context.Context, projectID int64) ([]ApprovalRule, error) {
-	data, err := request.GET(
-		ctx,
-		request.MustURL(fmt.Sprintf("%s/api/v4/projects/%d/approval_rules", c.baseURL, projectID)),
-		map[string]string{
-			tokenHeader: c.token,
-		},
+func (c *client) getGroupProjects(
+	ctx context.Context, groupPath string, includeSubgroups, includeArchived bool,
+) ([]Project, error) {
+	queryKV := []string{"include_subgroups", strconv.FormatBool(includeSubgroups), "order_by", "id"}
+	if !includeArchived {
+		queryKV = append(queryKV, "archived", "false")
+	}
+
+	res, err := getAllPagesCached[Project](
+		ctx, c,
+		fmt.Sprintf("%s/api/v4/groups/%s/projects", c.getSettings().URL, url.PathEscape(groupPath)),
+		queryKV...,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get project approval rules from gitlab: %w", err)
+		return nil, fmt.Errorf("failed to get group projects from gitlab: %w", err)
 	}
 
-	res := make([]ApprovalRule, 0)
-	if err = json.Unmarshal(data, &res); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal project approval rules: %w", err)
+	return res, nil
+}
+
+func (c *client) getApprovalRules(ctx context.Context, projectID int64) ([]ApprovalRule, error) {
+	res, err := getAllPagesCached[ApprovalRule](
+		ctx, c, fmt.Sprintf("%s/api/v4/projects/%d/approval_rules", c.getSettings().URL, projectID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project approval rules from gitlab: %w", err)
 	}
 
 	return res, nil
 }
 
 func (c *client) getCurrentUser(ctx context.Context) (User, error) {
-	data, err := request.GET(
+	data, err := c.http.GET(
 		ctx,
-		request.MustURL(fmt.Sprintf("%s/api/v4/user", c.baseURL)),
+		request.MustURL(fmt.Sprintf("%s/api/v4/user", c.getSettings().URL)),
 		map[string]string{
-			tokenHeader: c.token,
+			tokenHeader: c.getSettings().Token,
 		},
 	)
 	if err != nil {
@@ -109,12 +137,13 @@ func (c *client) getCurrentUser(ctx context.Context) (User, error) {
 	return res, nil
 }
 
-func (c *client) getMergeRequestsApprovals(ctx context.Context, projectID, mrIID int64) (Approval, error) {
-	data, err := request.GET(
+// getMergeRequestApprovals is not cached since approvals change often and are shown right after approve action
+func (c *client) getMergeRequestApprovals(ctx context.Context, projectID, mrIID int64) (Approval, error) {
+	data, err := c.http.GET(
 		ctx,
-		request.MustURL(fmt.Sprintf("%s/api/v4/projects/%d/merge_requests/%d/approvals", c.baseURL, projectID, mrIID)),
+		request.MustURL(fmt.Sprintf("%s/api/v4/projects/%d/merge_requests/%d/approvals", c.getSettings().URL, projectID, mrIID)),
 		map[string]string{
-			tokenHeader: c.token,
+			tokenHeader: c.getSettings().Token,
 		},
 	)
 	if err != nil {
@@ -129,58 +158,52 @@ func (c *client) getMergeRequestsApprovals(ctx context.Context, projectID, mrIID
 	return res, nil
 }
 
-func (c *client) getMergeRequestDiscussions(ctx context.Context, projectID, mergeRequestIID int64) ([]Discussion, error) {
-	data, err := request.GET(
-		ctx,
-		request.MustURL(
-			fmt.Sprintf("%s/api/v4/projects/%d/merge_requests/%d/discussions", c.baseURL, projectID, mergeRequestIID),
-			"per_page", "100", "page", "1", "state", "opened",
-		),
-		map[string]string{
-			tokenHeader: c.token,
-		},
+// getPipelineFailedJobs returns failed jobs of pipeline, it is not cached since retry of job or pipeline
+// replaces its jobs with new ones within the same pipeline
+func (c *client) getPipelineFailedJobs(ctx context.Context, projectID, pipelineID int64) ([]Job, error) {
+	res, err := getAllPages[Job](
+		ctx, c,
+		fmt.Sprintf("%s/api/v4/projects/%d/pipelines/%d/jobs", c.getSettings().URL, projectID, pipelineID),
+		"scope[]", "failed",
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get merge request discussions from gitlab: %w", err)
+		return nil, fmt.Errorf("failed to get pipeline jobs from gitlab: %w", err)
 	}
 
-	res := make([]Discussion, 0)
-	if err = json.Unmarshal(data, &res); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal merge request discussions info: %w", err)
+	return res, nil
+}
+
+func (c *client) getMergeRequestDiscussions(ctx context.Context, projectID, mergeRequestIID int64) ([]Discussion, error) {
+	res, err := getAllPages[Discussion](
+		ctx, c,
+		fmt.Sprintf("%s/api/v4/projects/%d/merge_requests/%d/discussions", c.getSettings().URL, projectID, mergeRequestIID),
+		"state", "opened",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get merge request discussions from gitlab: %w", err)
 	}
 
 	return res, nil
 }
 
 func (c *client) getMergeRequestCommits(ctx context.Context, projectID, mergeRequestIID int64) ([]Commit, error) {
-	data, err := request.GET(
-		ctx,
-		request.MustURL(
-			fmt.Sprintf("%s/api/v4/projects/%d/merge_requests/%d/commits", c.baseURL, projectID, mergeRequestIID),
-			"per_page", "100", "page", "1",
-		),
-		map[string]string{
-			tokenHeader: c.token,
-		},
+	res, err := getAllPages[Commit](
+		ctx, c,
+		fmt.Sprintf("%s/api/v4/projects/%d/merge_requests/%d/commits", c.getSettings().URL, projectID, mergeRequestIID),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get merge request commits from gitlab: %w", err)
 	}
 
-	res := make([]Commit, 0)
-	if err = json.Unmarshal(data, &res); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal merge request commits info: %w", err)
-	}
-
 	return res, nil
 }
 
 func (c *client) getMergeRequestInfo(ctx context.Context, projectID, mergeRequestIID int64) (MergeRequestInfo, error) {
-	data, err := request.GET(
+	data, err := c.http.GET(
 		ctx,
-		request.MustURL(fmt.Sprintf("%s/api/v4/projects/%d/merge_requests/%d", c.baseURL, projectID, mergeRequestIID)),
+		request.MustURL(fmt.Sprintf("%s/api/v4/projects/%d/merge_requests/%d", c.getSettings().URL, projectID, mergeRequestIID)),
 		map[string]string{
-			tokenHeader: c.token,
+			tokenHeader: c.getSettings().Token,
 		},
 	)
 	if err != nil {
@@ -195,41 +218,55 @@ func (c *client) getMergeRequestInfo(ctx context.Context, projectID, mergeReques
 	return res, nil
 }
 
-func (c *client) getProjectMergeRequestsGQ(ctx context.Context, projectFullPath string) ([]MergeRequestGQ, error) {
-	q := struct {
-		Query string `json:"query"`
-	}{
-		Query: fmt.Sprintf(projectMRsRequest, projectFullPath),
+func (c *client) getUserByUsername(ctx context.Context, username string) (User, error) {
+	data, err := c.http.GET(
+		ctx,
+		request.MustURL(fmt.Sprintf("%s/api/v4/users", c.getSettings().URL), "username", username),
+		map[string]string{
+			tokenHeader: c.getSettings().Token,
+		},
+	)
+	if err != nil {
+		return User{}, fmt.Errorf("failed to get user %s from gitlab: %w", username, err)
+	}
+
+	var res []User
+	if err = json.Unmarshal(data, &res); err != nil {
+		return User{}, fmt.Errorf("failed to unmarshal users: %w", err)
 	}
-	reqData, err := json.Marshal(q)
+	if len(res) == 0 {
+		return User{}, fmt.Errorf("user %s: %w", username, ErrNotFound)
+	}
+
+	return res[0], nil
+}
+
+// getMergeRequestDiffs returns changed files of merge request, diffs change with every push,
+// so they are not cached here, callers keep them by head commit
+func (c *client) getMergeRequestDiffs(ctx context.Context, projectID, mergeRequestIID int64) ([]Diff, error) {
+	res, err := getAllPages[Diff](
+		ctx, c,
+		fmt.Sprintf("%s/api/v4/projects/%d/merge_requests/%d/diffs", c.getSettings().URL, projectID, mergeRequestIID),
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal projects merge request: %w", err)
+		return nil, fmt.Errorf("failed to get merge request diffs from gitlab: %w", err)
 	}
 
-	data, err := request.POST(ctx,
-		request.MustURL(fmt.Sprintf("%s/api/graphql", c.baseURL)),
-		map[string]string{
-			authHeader:     fmt.Sprintf("Bearer %s", c.token),
-			"Content-Type": "application/json",
-		},
-		reqData,
+	return res, nil
+}
+
+// getRawFile returns content of repository file at ref, files are requested through response cache
+func (c *client) getRawFile(ctx context.Context, projectID int64, path, ref string) ([]byte, error) {
+	data, _, err := c.getCached(
+		ctx,
+		request.MustURL(
+			fmt.Sprintf("%s/api/v4/projects/%d/repository/files/%s/raw", c.getSettings().URL, projectID, url.PathEscape(path)),
+			"ref", ref,
+		),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get project merge requests from gitlab: %w", err)
-	}
-
-	res := struct {
-		Data struct {
-			Project struct {
-				MergeRequests struct {
-					Nodes []MergeRequestGQ `json:"nodes"`
-				} `json:"mergeRequests"`
-			} `json:"project"`
-		} `json:"data"`
-	}{}
-	if err = json.Unmarshal(data, &res); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal merge requests info: %w", err)
+		return nil, fmt.Errorf("failed to get file %s of project %d from gitlab: %w", path, projectID, err)
 	}
 
-	return res.Data.Project.MergeRequests.Nodes, nil
+	return data, nil
 }
@@ -0,0 +1,51 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/vlanse/glmr/internal/util/request"
+)
+
+var ErrNotFound = errors.New("not found")
+
+// ErrorStatusCode returns HTTP status code of failed gitlab request, 0 when it is unknown
+func ErrorStatusCode(err error) int {
+	var statusErr *request.StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode
+	}
+	if errors.Is(err, ErrNotFound) {
+		return http.StatusNotFound
+	}
+	return 0
+}
+
+// withResponseMessage adds error details reported by gitlab in response body to err
+func withResponseMessage(data []byte, err error) error {
+	resp := struct {
+		Message any    `json:"message"`
+		Error   string `json:"error"`
+	}{}
+	if jsonErr := json.Unmarshal(data, &resp); jsonErr != nil {
+		return err
+	}
+
+	var msg string
+	switch m := resp.Message.(type) {
+	case string:
+		msg = m
+	case nil:
+		msg = resp.Error
+	default:
+		// validation errors are reported as object or list
+		b, _ := json.Marshal(m)
+		msg = string(b)
+	}
+	if len(msg) == 0 {
+		return err
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
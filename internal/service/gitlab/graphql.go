@@ -1,61 +1,248 @@
 package gitlab
 
+import "fmt"
+
 const (
-	projectMRsRequest = `
+	projectMRsRequestPart = `
+  %s: project(fullPath: "%s") {
+    id
+    fullPath
+    name
+    webUrl
+    mergeRequests(state: opened, first: %d, after: %s) {
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+      nodes {
+        ...mergeRequestFields
+      }
+    }
+  }
+`
+
+	// projectPathsRequest resolves full paths of projects by their global IDs
+	projectPathsRequest = `
+{
+  projects(ids: [%s], first: %d) {
+    nodes {
+      id
+      fullPath
+    }
+  }
+}
+`
+
+	projectMRRequest = `
 {
   project(fullPath: "%s") {
-    mergeRequests(state: opened) {
+    mergeRequest(iid: "%d") {
+      ...mergeRequestFields
+    }
+  }
+}
+`
+
+	// currentUserMRsRequest has merge requests related to the current user across all projects of gitlab instance,
+	// connection is one of CurrentUserMergeRequests
+	currentUserMRsRequest = `
+{
+  currentUser {
+    mergeRequests: %s(state: opened, first: %d, after: %s) {
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
       nodes {
-        id
-        iid
-        projectId
-        createdAt
-        updatedAt
-        webUrl
-        conflicts
-        title
-        state
-        committers {
-          nodes {
-            id
-            username
-            avatarUrl
-            webUrl
-            name
-            publicEmail
-          }
-        }
-        approvedBy {
-          nodes {
-            id
-            username
-            avatarUrl
-            webUrl
-            name
-            publicEmail
-          }
-        }
-        author {
+        ...mergeRequestFields
+        project {
           id
-          username
-          avatarUrl
-          webUrl
+          fullPath
           name
-          publicEmail
+          webUrl
         }
-        headPipeline {
-          id
-          status
+      }
+    }
+  }
+}
+`
+
+	mergeRequestFragment = `
+fragment mergeRequestFields on MergeRequest {
+  id
+  iid
+  projectId
+  createdAt
+  updatedAt
+  webUrl
+  conflicts
+  title
+  description
+  state
+  draft
+  sourceBranch
+  targetBranch
+  diffHeadSha
+  labels {
+    nodes {
+      title
+      color
+    }
+  }
+  committers {
+    nodes {
+      ...userFields
+    }
+  }
+  approvedBy {
+    nodes {
+      ...userFields
+    }
+  }
+  author {
+    ...userFields
+  }
+  reviewers {
+    nodes {
+      ...userFields
+    }
+  }
+  assignees {
+    nodes {
+      ...userFields
+    }
+  }
+  milestone {
+    title
+    webPath
+    dueDate
+  }
+  headPipeline {
+    id
+    status
+    path
+    duration
+  }
+  diffStatsSummary {
+    additions
+    changes
+    fileCount
+    deletions
+  }
+  userPermissions {
+    canApprove
+  }
+  %s
+}
+`
+
+	userFragment = `
+fragment userFields on User {
+  id
+  username
+  avatarUrl
+  webUrl
+  name
+  publicEmail
+}
+`
+
+	// approvalsFields are available in GitLab Premium only
+	approvalsFields = `
+  approved
+  approvalsRequired
+  approvalsLeft
+`
+
+	// approvalStateFields are available in GitLab Premium only
+	approvalStateFields = `
+  approvalState {
+    rules {
+      id
+      name
+      type
+      approvalsRequired
+      approved
+      eligibleApprovers {
+        ...userFields
+      }
+      approvedBy {
+        nodes {
+          ...userFields
         }
-        diffStatsSummary {
-          additions
-          changes
-          fileCount
-          deletions
+      }
+    }
+  }
+`
+
+	// discussionsFields has all MR threads, when there are more threads than fit into one page,
+	// they are expected to be fetched with REST API
+	discussionsFields = `
+  discussions(first: %d) {
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+    nodes {
+      id
+      resolvable
+      resolved
+      notes {
+        nodes {
+          id
+          body
+          system
+          resolvable
+          resolved
+          resolvedAt
+          createdAt
+          author {
+            ...userFields
+          }
+          resolvedBy {
+            ...userFields
+          }
         }
       }
     }
   }
-}
 `
 )
+
+type optionalFieldGQ struct {
+	name   string
+	fields string
+	// declared are fields of the group as "Type.field", they are matched against unknown field errors
+	declared []string
+}
+
+// optionalMergeRequestFieldsGQ are dropped from merge request queries when gitlab instance does not support them,
+// the heaviest go last
+var optionalMergeRequestFieldsGQ = []optionalFieldGQ{
+	{
+		name:   "approvalsLeft",
+		fields: approvalsFields,
+		declared: []string{
+			"MergeRequest.approved", "MergeRequest.approvalsRequired", "MergeRequest.approvalsLeft",
+		},
+	},
+	{
+		name:   "approvalState",
+		fields: approvalStateFields,
+		declared: []string{
+			"MergeRequest.approvalState", "MergeRequestApprovalState.rules",
+			"ApprovalRule.id", "ApprovalRule.name", "ApprovalRule.type", "ApprovalRule.approvalsRequired",
+			"ApprovalRule.approved", "ApprovalRule.eligibleApprovers", "ApprovalRule.approvedBy",
+		},
+	},
+	{
+		name:   "discussions",
+		fields: fmt.Sprintf(discussionsFields, pageSize),
+		declared: []string{
+			"MergeRequest.discussions",
+			"Discussion.id", "Discussion.resolvable", "Discussion.resolved", "Discussion.notes",
+			"Note.id", "Note.body", "Note.system", "Note.resolvable", "Note.resolved", "Note.resolvedAt",
+			"Note.createdAt", "Note.author", "Note.resolvedBy",
+		},
+	},
+}
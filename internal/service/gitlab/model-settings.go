@@ -0,0 +1,20 @@
+package gitlab
+
+import (
+	"time"
+
+	"github.com/vlanse/glmr/internal/util/request"
+)
+
+type Settings struct {
+	URL   string
+	Token string
+	// MaxPages limits the number of pages fetched for any paginated collection, default is used when not set
+	MaxPages int
+	// GraphQLBatchSize is the number of projects requested by single GraphQL query, default is used when not set
+	GraphQLBatchSize int
+	// HTTP configures timeouts, retries and limits of requests to gitlab
+	HTTP request.Settings
+	// CacheTTL is how long rarely changing resources like project info are served from cache without revalidation
+	CacheTTL time.Duration
+}
@@ -1,11 +1,18 @@
 package gitlab
 
-import "time"
+import (
+	"time"
+
+	"github.com/samber/lo"
+)
 
 type Project struct {
 	ID                int64  `json:"id"`
+	Name              string `json:"name"`
+	Path              string `json:"path"`
 	WebURL            string `json:"web_url"`
 	PathWithNamespace string `json:"path_with_namespace"`
+	Archived          bool   `json:"archived"`
 }
 
 type MergeRequest struct {
@@ -35,6 +42,7 @@ type ApprovalRule struct {
 }
 
 type User struct {
+	ID          int64  `json:"id"`
 	Username    string `json:"username"`
 	AvatarURL   string `json:"avatar_url"`
 	WebURL      string `json:"web_url"`
@@ -48,12 +56,18 @@ type ApprovedBy struct {
 }
 
 type Approval struct {
-	ApprovedBy []ApprovedBy `json:"approved_by"`
+	Approved          bool         `json:"approved"`
+	ApprovalsRequired int          `json:"approvals_required"`
+	ApprovalsLeft     int          `json:"approvals_left"`
+	UserCanApprove    bool         `json:"user_can_approve"`
+	UserHasApproved   bool         `json:"user_has_approved"`
+	ApprovedBy        []ApprovedBy `json:"approved_by"`
 }
 
 type Note struct {
 	ID         int64     `json:"id"`
 	Type       string    `json:"type"`
+	System     bool      `json:"system"`
 	Resolvable bool      `json:"resolvable"`
 	Resolved   bool      `json:"resolved"`
 	Author     User      `json:"author"`
@@ -69,6 +83,16 @@ type Discussion struct {
 	Notes          []Note `json:"notes"`
 }
 
+// Resolved tells whether all resolvable notes of the discussion are resolved
+func (d Discussion) Resolved() bool {
+	resolvable := lo.Filter(d.Notes, func(item Note, _ int) bool {
+		return item.Resolvable
+	})
+	return len(resolvable) > 0 && lo.EveryBy(resolvable, func(item Note) bool {
+		return item.Resolved
+	})
+}
+
 type Commit struct {
 	ID          string    `json:"id"`
 	CreatedAt   time.Time `json:"created_at"`
@@ -86,11 +110,30 @@ type MergeRequestInfo struct {
 	Description string    `json:"description"`
 	Reviewers   []User    `json:"reviewers"`
 	WebURL      string    `json:"web_url"`
-	Pipeline    struct {
+	// MergedAt is nil when merge request is not merged
+	MergedAt *time.Time `json:"merged_at"`
+	Pipeline struct {
 		Status string `json:"status"`
 	} `json:"pipeline"`
 }
 
+// Diff is a change of single file in merge request
+type Diff struct {
+	OldPath string `json:"old_path"`
+	NewPath string `json:"new_path"`
+}
+
+type Job struct {
+	ID            int64   `json:"id"`
+	Name          string  `json:"name"`
+	Stage         string  `json:"stage"`
+	Status        string  `json:"status"`
+	WebURL        string  `json:"web_url"`
+	Duration      float64 `json:"duration"` // seconds
+	AllowFailure  bool    `json:"allow_failure"`
+	FailureReason string  `json:"failure_reason"`
+}
+
 type UserGQ struct {
 	ID          string `json:"id"`
 	Username    string `json:"username"`
@@ -100,9 +143,22 @@ type UserGQ struct {
 	PublicEmail string `json:"publicEmail"`
 }
 
+type LabelGQ struct {
+	Title string `json:"title"`
+	Color string `json:"color"`
+}
+
+type MilestoneGQ struct {
+	Title   string `json:"title"`
+	WebPath string `json:"webPath"`
+	DueDate string `json:"dueDate"` // ISO 8601 date or time, empty when not set
+}
+
 type PipelineGQ struct {
-	ID     string `json:"id"`
-	Status string `json:"status"`
+	ID       string `json:"id"`
+	Status   string `json:"status"`
+	Path     string `json:"path"`     // relative web URL
+	Duration int64  `json:"duration"` // seconds
 }
 
 type DiffStatsGQ struct {
@@ -112,22 +168,122 @@ type DiffStatsGQ struct {
 	FileCount int64 `json:"fileCount"`
 }
 
-type MergeRequestGQ struct {
-	IID        int64     `json:"iid,string"`
-	ProjectID  int64     `json:"projectId"`
+type ApprovalRuleGQ struct {
+	ID                string   `json:"id"`
+	Name              string   `json:"name"`
+	Type              string   `json:"type"`
+	ApprovalsRequired int      `json:"approvalsRequired"`
+	Approved          bool     `json:"approved"`
+	EligibleApprovers []UserGQ `json:"eligibleApprovers"`
+	ApprovedBy        struct {
+		Nodes []UserGQ `json:"nodes"`
+	} `json:"approvedBy"`
+}
+
+type ApprovalStateGQ struct {
+	Rules []ApprovalRuleGQ `json:"rules"`
+}
+
+type NoteGQ struct {
+	ID         string    `json:"id"`
+	Body       string    `json:"body"`
+	System     bool      `json:"system"`
+	Resolvable bool      `json:"resolvable"`
+	Resolved   bool      `json:"resolved"`
+	ResolvedAt time.Time `json:"resolvedAt"`
 	CreatedAt  time.Time `json:"createdAt"`
-	UpdatedAt  time.Time `json:"updatedAt"`
-	WebURL     string    `json:"webUrl"`
-	Conflicts  bool      `json:"conflicts"`
-	Title      string    `json:"title"`
-	State      string    `json:"state"`
+	Author     UserGQ    `json:"author"`
+	ResolvedBy UserGQ    `json:"resolvedBy"`
+}
+
+type DiscussionGQ struct {
+	ID         string `json:"id"`
+	Resolvable bool   `json:"resolvable"`
+	Resolved   bool   `json:"resolved"`
+	Notes      struct {
+		Nodes []NoteGQ `json:"nodes"`
+	} `json:"notes"`
+}
+
+type DiscussionsGQ struct {
+	Nodes    []DiscussionGQ `json:"nodes"`
+	PageInfo pageInfoGQ     `json:"pageInfo"`
+}
+
+// Complete reports whether all MR discussions fit into a single page
+func (d *DiscussionsGQ) Complete() bool {
+	return d != nil && !d.PageInfo.HasNextPage
+}
+
+type MergeRequestGQ struct {
+	IID          int64     `json:"iid,string"`
+	ProjectID    int64     `json:"projectId"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+	WebURL       string    `json:"webUrl"`
+	Conflicts    bool      `json:"conflicts"`
+	Title        string    `json:"title"`
+	Description  string    `json:"description"`
+	State        string    `json:"state"`
+	Draft        bool      `json:"draft"`
+	SourceBranch string    `json:"sourceBranch"`
+	TargetBranch string    `json:"targetBranch"`
+	DiffHeadSHA  string    `json:"diffHeadSha"`
+	Labels       struct {
+		Nodes []LabelGQ `json:"nodes"`
+	} `json:"labels"`
 	Committers struct {
 		Nodes []UserGQ `json:"nodes"`
 	} `json:"committers"`
 	ApprovedBy struct {
 		Nodes []UserGQ `json:"nodes"`
 	} `json:"approvedBy"`
-	Author           UserGQ      `json:"author"`
-	HeadPipeline     PipelineGQ  `json:"headPipeline"`
-	DiffStatsSummary DiffStatsGQ `json:"diffStatsSummary"`
+	Reviewers struct {
+		Nodes []UserGQ `json:"nodes"`
+	} `json:"reviewers"`
+	Assignees struct {
+		Nodes []UserGQ `json:"nodes"`
+	} `json:"assignees"`
+	// Milestone is nil when merge request has no milestone
+	Milestone        *MilestoneGQ `json:"milestone"`
+	Author           UserGQ       `json:"author"`
+	HeadPipeline     PipelineGQ   `json:"headPipeline"`
+	DiffStatsSummary DiffStatsGQ  `json:"diffStatsSummary"`
+	UserPermissions  struct {
+		CanApprove bool `json:"canApprove"`
+	} `json:"userPermissions"`
+	Approved bool `json:"approved"`
+	// ApprovalsRequired and ApprovalsLeft are nil when gitlab instance does not provide them
+	ApprovalsRequired *int `json:"approvalsRequired"`
+	ApprovalsLeft     *int `json:"approvalsLeft"`
+	// ApprovalState is nil when gitlab instance does not provide it
+	ApprovalState *ApprovalStateGQ `json:"approvalState"`
+	// Discussions is nil when gitlab instance does not provide them
+	Discussions *DiscussionsGQ `json:"discussions"`
+	// Project is set only when merge requests are queried across projects
+	Project *ProjectGQ `json:"project"`
+}
+
+type ProjectGQ struct {
+	ID            string           `json:"id"`
+	FullPath      string           `json:"fullPath"`
+	Name          string           `json:"name"`
+	WebURL        string           `json:"webUrl"`
+	MergeRequests []MergeRequestGQ `json:"-"`
+}
+
+// CurrentUserMergeRequests is a connection of the current user with related merge requests
+type CurrentUserMergeRequests string
+
+const (
+	ReviewRequestedMergeRequests CurrentUserMergeRequests = "reviewRequestedMergeRequests"
+	AssignedMergeRequests        CurrentUserMergeRequests = "assignedMergeRequests"
+	AuthoredMergeRequests        CurrentUserMergeRequests = "authoredMergeRequests"
+)
+
+type MergeOptions struct {
+	Squash             bool
+	RemoveSourceBranch bool
+	// WhenPipelineSucceeds schedules merge instead of merging immediately when pipeline is still running
+	WhenPipelineSucceeds bool
 }
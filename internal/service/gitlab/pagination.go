@@ -0,0 +1,112 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	"github.com/vlanse/glmr/internal/util/request"
+)
+
+const (
+	pageSize = 100
+
+	defaultMaxPages = 10
+
+	nextPageHeader = "X-Next-Page"
+	linkHeader     = "Link"
+)
+
+var nextLinkRx = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+type pageInfoGQ struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// getAllPages fetches every page of a REST collection, following X-Next-Page header (offset pagination)
+// or Link header (keyset pagination), but not more than configured max pages
+func getAllPages[T any](ctx context.Context, c *client, path string, queryKV ...string) ([]T, error) {
+	return fetchAllPages[T](ctx, c, false, path, queryKV...)
+}
+
+// getAllPagesCached works like getAllPages, but pages are requested through response cache
+func getAllPagesCached[T any](ctx context.Context, c *client, path string, queryKV ...string) ([]T, error) {
+	return fetchAllPages[T](ctx, c, true, path, queryKV...)
+}
+
+func fetchAllPages[T any](ctx context.Context, c *client, cached bool, path string, queryKV ...string) ([]T, error) {
+	nextURL := request.MustURL(
+		path,
+		append(queryKV, "per_page", strconv.Itoa(pageSize), "page", "1")...,
+	)
+
+	res := make([]T, 0)
+	for page := 0; len(nextURL) > 0; page++ {
+		if page >= c.getMaxPages() {
+			log.Printf("gitlab: %s has more than %d pages, the rest is skipped", path, c.getMaxPages())
+			break
+		}
+
+		var (
+			data   []byte
+			header http.Header
+			err    error
+		)
+		if cached {
+			data, header, err = c.getCached(ctx, nextURL)
+		} else {
+			data, header, err = c.http.GETWithHeaders(
+				ctx,
+				nextURL,
+				map[string]string{
+					tokenHeader: c.getSettings().Token,
+				},
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var items []T
+		if err = json.Unmarshal(data, &items); err != nil {
+			return nil, fmt.Errorf("unmarshal page %d: %w", page+1, err)
+		}
+		res = append(res, items...)
+
+		nextURL = nextPageURL(nextURL, header)
+	}
+
+	return res, nil
+}
+
+func nextPageURL(currentURL string, header http.Header) string {
+	if nextPage := header.Get(nextPageHeader); len(nextPage) > 0 {
+		u, err := url.Parse(currentURL)
+		if err != nil {
+			return ""
+		}
+		q := u.Query()
+		q.Set("page", nextPage)
+		u.RawQuery = q.Encode()
+		return u.String()
+	}
+
+	if m := nextLinkRx.FindStringSubmatch(header.Get(linkHeader)); len(m) == 2 {
+		return m[1]
+	}
+
+	return ""
+}
+
+func (c *client) getMaxPages() int {
+	if maxPages := c.getSettings().MaxPages; maxPages > 0 {
+		return maxPages
+	}
+	return defaultMaxPages
+}
@@ -0,0 +1,80 @@
+package gitlab
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNextPageURL(t *testing.T) {
+	tests := []struct {
+		name       string
+		currentURL string
+		header     http.Header
+		want       string
+	}{
+		{
+			name:       "offset pagination",
+			currentURL: "https://gitlab.example.com/api/v4/projects/1/merge_requests?page=1&per_page=100&state=opened",
+			header:     http.Header{nextPageHeader: []string{"2"}},
+			want:       "https://gitlab.example.com/api/v4/projects/1/merge_requests?page=2&per_page=100&state=opened",
+		},
+		{
+			name:       "offset pagination without page in current url",
+			currentURL: "https://gitlab.example.com/api/v4/users?username=alice",
+			header:     http.Header{nextPageHeader: []string{"3"}},
+			want:       "https://gitlab.example.com/api/v4/users?page=3&username=alice",
+		},
+		{
+			name:       "keyset pagination",
+			currentURL: "https://gitlab.example.com/api/v4/projects?pagination=keyset&per_page=100",
+			header: http.Header{linkHeader: []string{
+				`<https://gitlab.example.com/api/v4/projects?id_after=42&pagination=keyset&per_page=100>; rel="next"`,
+			}},
+			want: "https://gitlab.example.com/api/v4/projects?id_after=42&pagination=keyset&per_page=100",
+		},
+		{
+			name:       "keyset pagination with several links",
+			currentURL: "https://gitlab.example.com/api/v4/projects?pagination=keyset",
+			header: http.Header{linkHeader: []string{
+				`<https://gitlab.example.com/api/v4/projects?id_after=1>; rel="first", ` +
+					`<https://gitlab.example.com/api/v4/projects?id_after=42>; rel="next"`,
+			}},
+			want: "https://gitlab.example.com/api/v4/projects?id_after=42",
+		},
+		{
+			name:       "next page header takes precedence over link",
+			currentURL: "https://gitlab.example.com/api/v4/groups/g/projects?page=1",
+			header: http.Header{
+				nextPageHeader: []string{"2"},
+				linkHeader:     []string{`<https://gitlab.example.com/other>; rel="next"`},
+			},
+			want: "https://gitlab.example.com/api/v4/groups/g/projects?page=2",
+		},
+		{
+			name:       "last page of offset pagination",
+			currentURL: "https://gitlab.example.com/api/v4/projects/1/merge_requests?page=5",
+			header:     http.Header{nextPageHeader: []string{""}},
+			want:       "",
+		},
+		{
+			name:       "last page of keyset pagination",
+			currentURL: "https://gitlab.example.com/api/v4/projects?pagination=keyset",
+			header:     http.Header{linkHeader: []string{`<https://gitlab.example.com/api/v4/projects?id_after=1>; rel="first"`}},
+			want:       "",
+		},
+		{
+			name:       "no pagination headers",
+			currentURL: "https://gitlab.example.com/api/v4/projects/1/approval_rules",
+			header:     http.Header{},
+			want:       "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nextPageURL(tt.currentURL, tt.header); got != tt.want {
+				t.Errorf("nextPageURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
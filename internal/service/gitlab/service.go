@@ -6,29 +6,41 @@ type Service struct {
 	cl *client
 }
 
-func NewService(baseURL, token string) *Service {
+func NewService(settings Settings) *Service {
 	return &Service{
-		cl: newClient(baseURL, token),
+		cl: newClient(settings),
 	}
 }
 
-func (s *Service) UpdateSettings(baseURL, token string) {
-	s.cl.baseURL = baseURL
-	s.cl.token = token
+func (s *Service) UpdateSettings(settings Settings) {
+	s.cl.updateSettings(settings)
 }
 
 func (s *Service) GetBaseURL() string {
-	return s.cl.baseURL
+	return s.cl.getSettings().URL
 }
 
 func (s *Service) GetProject(ctx context.Context, projectID int64) (Project, error) {
 	return s.cl.getProject(ctx, projectID)
 }
 
+// GetGroupProjects returns projects of the group (namespace) with given full path
+func (s *Service) GetGroupProjects(
+	ctx context.Context, groupPath string, includeSubgroups, includeArchived bool,
+) ([]Project, error) {
+	return s.cl.getGroupProjects(ctx, groupPath, includeSubgroups, includeArchived)
+}
+
 func (s *Service) GetApprovalRules(ctx context.Context, projectID int64) ([]ApprovalRule, error) {
 	return s.cl.getApprovalRules(ctx, projectID)
 }
 
+// GetMergeRequestApprovals returns approvals summary of merge request, it is meant for gitlab instances
+// which do not provide approvals via GraphQL
+func (s *Service) GetMergeRequestApprovals(ctx context.Context, projectID, mergeRequestIID int64) (Approval, error) {
+	return s.cl.getMergeRequestApprovals(ctx, projectID, mergeRequestIID)
+}
+
 func (s *Service) GetCurrentUser(ctx context.Context) (User, error) {
 	return s.cl.getCurrentUser(ctx)
 }
@@ -37,6 +49,106 @@ func (s *Service) GetMergeRequestDiscussions(ctx context.Context, projectID, mer
 	return s.cl.getMergeRequestDiscussions(ctx, projectID, mergeRequestIID)
 }
 
-func (s *Service) GetProjectMergeRequestsGQ(ctx context.Context, projectPath string) ([]MergeRequestGQ, error) {
-	return s.cl.getProjectMergeRequestsGQ(ctx, projectPath)
+// GetProjectPathsGQ returns full paths of projects keyed by project ID,
+// projects which do not exist or are not accessible are missing in result
+func (s *Service) GetProjectPathsGQ(ctx context.Context, projectIDs []int64) (map[int64]string, error) {
+	return s.cl.getProjectPathsGQ(ctx, projectIDs)
+}
+
+// GetProjectsMergeRequestsGQ returns projects with their opened merge requests keyed by project path,
+// projects which could not be fetched are reported in errors map
+func (s *Service) GetProjectsMergeRequestsGQ(
+	ctx context.Context, projectPaths []string,
+) (map[string]ProjectGQ, map[string]error) {
+	return s.cl.getProjectsMergeRequestsGQ(ctx, projectPaths)
+}
+
+// GetCurrentUserMergeRequestsGQ returns opened merge requests related to the current user across all projects,
+// every merge request has its Project set
+func (s *Service) GetCurrentUserMergeRequestsGQ(
+	ctx context.Context, connection CurrentUserMergeRequests,
+) ([]MergeRequestGQ, error) {
+	return s.cl.getCurrentUserMergeRequestsGQ(ctx, connection)
+}
+
+func (s *Service) GetMergeRequestGQ(ctx context.Context, projectPath string, mergeRequestIID int64) (MergeRequestGQ, error) {
+	return s.cl.getMergeRequestGQ(ctx, projectPath, mergeRequestIID)
+}
+
+func (s *Service) GetMergeRequestInfo(ctx context.Context, projectID, mergeRequestIID int64) (MergeRequestInfo, error) {
+	return s.cl.getMergeRequestInfo(ctx, projectID, mergeRequestIID)
+}
+
+func (s *Service) ApproveMergeRequest(ctx context.Context, projectID, mergeRequestIID int64) error {
+	return s.cl.approveMergeRequest(ctx, projectID, mergeRequestIID)
+}
+
+func (s *Service) UnapproveMergeRequest(ctx context.Context, projectID, mergeRequestIID int64) error {
+	return s.cl.unapproveMergeRequest(ctx, projectID, mergeRequestIID)
+}
+
+func (s *Service) MergeMergeRequest(ctx context.Context, projectID, mergeRequestIID int64, options MergeOptions) error {
+	return s.cl.mergeMergeRequest(ctx, projectID, mergeRequestIID, options)
+}
+
+// RebaseMergeRequest starts rebase of merge request source branch, rebase itself is done by gitlab asynchronously
+func (s *Service) RebaseMergeRequest(ctx context.Context, projectID, mergeRequestIID int64) error {
+	return s.cl.rebaseMergeRequest(ctx, projectID, mergeRequestIID)
+}
+
+func (s *Service) GetMergeRequestDiscussion(
+	ctx context.Context, projectID, mergeRequestIID int64, discussionID string,
+) (Discussion, error) {
+	return s.cl.getMergeRequestDiscussion(ctx, projectID, mergeRequestIID, discussionID)
+}
+
+// CreateMergeRequestDiscussion starts new thread on merge request with given comment
+func (s *Service) CreateMergeRequestDiscussion(
+	ctx context.Context, projectID, mergeRequestIID int64, body string,
+) (Discussion, error) {
+	return s.cl.createMergeRequestDiscussion(ctx, projectID, mergeRequestIID, body)
+}
+
+func (s *Service) ReplyToMergeRequestDiscussion(
+	ctx context.Context, projectID, mergeRequestIID int64, discussionID string, body string,
+) (Note, error) {
+	return s.cl.replyToMergeRequestDiscussion(ctx, projectID, mergeRequestIID, discussionID, body)
+}
+
+func (s *Service) ResolveMergeRequestDiscussion(
+	ctx context.Context, projectID, mergeRequestIID int64, discussionID string, resolved bool,
+) (Discussion, error) {
+	return s.cl.resolveMergeRequestDiscussion(ctx, projectID, mergeRequestIID, discussionID, resolved)
+}
+
+// GetPipelineFailedJobs returns failed jobs of pipeline including ones allowed to fail
+func (s *Service) GetPipelineFailedJobs(ctx context.Context, projectID, pipelineID int64) ([]Job, error) {
+	return s.cl.getPipelineFailedJobs(ctx, projectID, pipelineID)
+}
+
+// RetryPipeline retries failed and canceled jobs of pipeline
+func (s *Service) RetryPipeline(ctx context.Context, projectID, pipelineID int64) error {
+	return s.cl.retryPipeline(ctx, projectID, pipelineID)
+}
+
+func (s *Service) RetryJob(ctx context.Context, projectID, jobID int64) error {
+	return s.cl.retryJob(ctx, projectID, jobID)
+}
+
+func (s *Service) GetUserByUsername(ctx context.Context, username string) (User, error) {
+	return s.cl.getUserByUsername(ctx, username)
+}
+
+func (s *Service) GetMergeRequestDiffs(ctx context.Context, projectID, mergeRequestIID int64) ([]Diff, error) {
+	return s.cl.getMergeRequestDiffs(ctx, projectID, mergeRequestIID)
+}
+
+// GetRawFile returns content of repository file at ref (branch, tag or commit)
+func (s *Service) GetRawFile(ctx context.Context, projectID int64, path, ref string) ([]byte, error) {
+	return s.cl.getRawFile(ctx, projectID, path, ref)
+}
+
+// SetMergeRequestReviewers replaces reviewers of merge request
+func (s *Service) SetMergeRequestReviewers(ctx context.Context, projectID, mergeRequestIID int64, reviewerIDs []int64) error {
+	return s.cl.setMergeRequestReviewers(ctx, projectID, mergeRequestIID, reviewerIDs)
 }
@@ -0,0 +1,112 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/vlanse/glmr/internal/util/request"
+)
+
+func TestServiceUpdateSettings(t *testing.T) {
+	var ruleRequests atomic.Int32
+	newServer := func(username string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/api/v4/user":
+				_, _ = fmt.Fprintf(w, `{"username": %q, "name": %q}`, username, r.Header.Get(tokenHeader))
+			case "/api/v4/projects/1/approval_rules":
+				ruleRequests.Add(1)
+				_, _ = fmt.Fprint(w, `[{"id": 1, "name": "rule"}]`)
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+	}
+	first, second := newServer("alice"), newServer("bob")
+	defer first.Close()
+	defer second.Close()
+
+	settings := func(url, token string) Settings {
+		return Settings{URL: url, Token: token, HTTP: request.Settings{MaxRetries: -1}}
+	}
+	svc := NewService(settings(first.URL, "token-1"))
+	ctx := context.Background()
+
+	// requests are made while settings change, this is a data race if settings are not guarded
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			for range 20 {
+				if _, err := svc.GetCurrentUser(ctx); err != nil {
+					t.Errorf("GetCurrentUser() error: %v", err)
+				}
+			}
+		})
+	}
+	for i := range 20 {
+		svc.UpdateSettings(settings(first.URL, fmt.Sprintf("token-%d", i%2+1)))
+	}
+	wg.Wait()
+
+	tests := []struct {
+		name           string
+		settings       Settings
+		wantUser       User
+		wantRulesFetch bool
+	}{
+		{
+			name:           "cached response is reused with the same settings",
+			settings:       settings(first.URL, "token-1"),
+			wantUser:       User{Username: "alice", Name: "token-1"},
+			wantRulesFetch: false,
+		},
+		{
+			name:           "cache is dropped when token changes",
+			settings:       settings(first.URL, "token-2"),
+			wantUser:       User{Username: "alice", Name: "token-2"},
+			wantRulesFetch: true,
+		},
+		{
+			name:           "cache is dropped when URL changes",
+			settings:       settings(second.URL, "token-2"),
+			wantUser:       User{Username: "bob", Name: "token-2"},
+			wantRulesFetch: true,
+		},
+	}
+
+	svc.UpdateSettings(settings(first.URL, "token-1"))
+	if _, err := svc.GetApprovalRules(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc.UpdateSettings(tt.settings)
+
+			if got := svc.GetBaseURL(); got != tt.settings.URL {
+				t.Errorf("GetBaseURL() = %q, want %q", got, tt.settings.URL)
+			}
+
+			user, err := svc.GetCurrentUser(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if user.Username != tt.wantUser.Username || user.Name != tt.wantUser.Name {
+				t.Errorf("GetCurrentUser() = %+v, want %+v", user, tt.wantUser)
+			}
+
+			before := ruleRequests.Load()
+			if _, err = svc.GetApprovalRules(ctx, 1); err != nil {
+				t.Fatal(err)
+			}
+			if fetched := ruleRequests.Load() > before; fetched != tt.wantRulesFetch {
+				t.Errorf("GetApprovalRules() fetched = %v, want %v", fetched, tt.wantRulesFetch)
+			}
+		})
+	}
+}
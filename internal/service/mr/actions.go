@@ -0,0 +1,176 @@
+package mr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/vlanse/glmr/internal/service/gitlab"
+)
+
+type MergeOptions struct {
+	Squash               bool
+	RemoveSourceBranch   bool
+	WhenPipelineSucceeds bool
+}
+
+// Approve approves merge request on behalf of the current user and returns its refreshed state,
+// returned merge request is nil when it is not opened anymore, is not shown on dashboard or could not be refreshed
+func (s *Service) Approve(ctx context.Context, projectID, mergeRequestIID int64) (*MergeRequest, error) {
+	if err := s.gitlabSvc.ApproveMergeRequest(ctx, projectID, mergeRequestIID); err != nil {
+		return nil, err
+	}
+	return s.refreshAfterAction(ctx, projectID, mergeRequestIID), nil
+}
+
+// Unapprove revokes approval of the current user
+func (s *Service) Unapprove(ctx context.Context, projectID, mergeRequestIID int64) (*MergeRequest, error) {
+	if err := s.gitlabSvc.UnapproveMergeRequest(ctx, projectID, mergeRequestIID); err != nil {
+		return nil, err
+	}
+	return s.refreshAfterAction(ctx, projectID, mergeRequestIID), nil
+}
+
+func (s *Service) Merge(
+	ctx context.Context, projectID, mergeRequestIID int64, options MergeOptions,
+) (*MergeRequest, error) {
+	if err := s.gitlabSvc.MergeMergeRequest(ctx, projectID, mergeRequestIID, gitlab.MergeOptions{
+		Squash:               options.Squash,
+		RemoveSourceBranch:   options.RemoveSourceBranch,
+		WhenPipelineSucceeds: options.WhenPipelineSucceeds,
+	}); err != nil {
+		return nil, err
+	}
+	return s.refreshAfterAction(ctx, projectID, mergeRequestIID), nil
+}
+
+// Rebase starts rebase of merge request, gitlab does it asynchronously,
+// so returned merge request may not reflect its result yet
+func (s *Service) Rebase(ctx context.Context, projectID, mergeRequestIID int64) (*MergeRequest, error) {
+	if err := s.gitlabSvc.RebaseMergeRequest(ctx, projectID, mergeRequestIID); err != nil {
+		return nil, err
+	}
+	return s.refreshAfterAction(ctx, projectID, mergeRequestIID), nil
+}
+
+// refreshAfterAction returns refreshed state of merge request after action is done in gitlab, refresh failure
+// is only logged since the action itself succeeded and should not be retried by the client
+func (s *Service) refreshAfterAction(ctx context.Context, projectID, mergeRequestIID int64) *MergeRequest {
+	res, err := s.refreshMergeRequest(ctx, projectID, mergeRequestIID)
+	if err != nil {
+		log.Printf("could not refresh merge request %d of project %d after action: %v", mergeRequestIID, projectID, err)
+		return nil
+	}
+	return res
+}
+
+// refreshMergeRequest fetches fresh state of single merge request and puts it into the current snapshot
+// in place of the old one, merge request which is not opened anymore is removed from snapshot
+func (s *Service) refreshMergeRequest(ctx context.Context, projectID, mergeRequestIID int64) (*MergeRequest, error) {
+	project, err := s.gitlabSvc.GetProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("refresh merge request: %w", err)
+	}
+	mrGQ, err := s.gitlabSvc.GetMergeRequestGQ(ctx, project.PathWithNamespace, mergeRequestIID)
+	if err != nil {
+		return nil, fmt.Errorf("refresh merge request: %w", err)
+	}
+
+	prev := s.loadSnapshot()
+	if prev == nil {
+		return nil, nil
+	}
+
+	// merge request is prepared before taking refresh lock, since reviewer suggestions may need gitlab requests;
+	// it is prepared for every group it is shown in, as status depends on SLA of the group
+	opened := mrGQ.State == openedState
+	refreshedByGroup := make(map[string]MergeRequest)
+	if opened {
+		settings := s.getSettings()
+		for _, p := range prev.projects {
+			idx := slices.IndexFunc(p.MergeRequests, func(item MergeRequest) bool {
+				return item.IID == mergeRequestIID
+			})
+			if p.ID != projectID || p.Error != nil || idx == -1 {
+				continue
+			}
+
+			single := p
+			single.MergeRequests = []MergeRequest{s.mergeRequestFromGQ(p.MergeRequests[idx].Project, mrGQ)}
+			single = s.enrichMergeRequests(ctx, []Project{single})[0]
+			single = decorateMergeRequests(settings, s.getRules(), prev.currentUserName, []Project{single})[0]
+			single = s.suggestReviewers(ctx, settings.ReviewerSuggestions, []Project{single}, prev.projects)[0]
+			refreshedByGroup[p.GroupName] = single.MergeRequests[0]
+		}
+	}
+
+	// full refresh would overwrite snapshot with possibly older state of merge request
+	s.refreshMx.Lock()
+	defer s.refreshMx.Unlock()
+
+	// snapshot could be replaced by full refresh meanwhile
+	prev = s.loadSnapshot()
+	projects := slices.Clone(prev.projects)
+
+	var res *MergeRequest
+	for i, p := range projects {
+		idx := slices.IndexFunc(p.MergeRequests, func(item MergeRequest) bool {
+			return item.IID == mergeRequestIID
+		})
+		if p.ID != projectID || p.Error != nil || idx == -1 {
+			continue
+		}
+
+		mrs := slices.Clone(p.MergeRequests)
+		if !opened {
+			projects[i].MergeRequests = slices.Delete(mrs, idx, idx+1)
+			continue
+		}
+
+		refreshed, found := refreshedByGroup[p.GroupName]
+		if !found {
+			continue
+		}
+		mrs[idx] = refreshed
+		projects[i].MergeRequests = mrs
+		res = lo.ToPtr(mrs[idx])
+	}
+
+	snap := &snapshot{
+		projects:        projects,
+		currentUserName: prev.currentUserName,
+		updatedAt:       prev.updatedAt,
+	}
+
+	s.snapshotMx.Lock()
+	s.snapshot = snap
+	s.snapshotMx.Unlock()
+
+	now := time.Now()
+	events := s.diffSnapshots(ctx, prev, snap, now)
+	s.recordHistory(ctx, snap, events, now)
+	s.publish(events)
+
+	if res != nil {
+		res = lo.ToPtr(s.withLocalState(ctx, now, *res))
+	}
+	return res, nil
+}
+
+// RetryPipeline retries failed jobs of merge request pipeline
+func (s *Service) RetryPipeline(ctx context.Context, projectID, mergeRequestIID, pipelineID int64) (*MergeRequest, error) {
+	if err := s.gitlabSvc.RetryPipeline(ctx, projectID, pipelineID); err != nil {
+		return nil, err
+	}
+	return s.refreshAfterAction(ctx, projectID, mergeRequestIID), nil
+}
+
+func (s *Service) RetryJob(ctx context.Context, projectID, mergeRequestIID, jobID int64) (*MergeRequest, error) {
+	if err := s.gitlabSvc.RetryJob(ctx, projectID, jobID); err != nil {
+		return nil, err
+	}
+	return s.refreshAfterAction(ctx, projectID, mergeRequestIID), nil
+}
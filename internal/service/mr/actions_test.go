@@ -0,0 +1,141 @@
+package mr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/vlanse/glmr/internal/service/gitlab"
+	"github.com/vlanse/glmr/internal/util/request"
+)
+
+func TestActions(t *testing.T) {
+	const (
+		projectID  = 1
+		iid        = 2
+		pipelineID = 3
+		jobID      = 4
+	)
+
+	var (
+		mx         sync.Mutex
+		requests   []string
+		actionCode int
+	)
+	gitlabSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mx.Lock()
+		defer mx.Unlock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodGet {
+			// merge request can not be refreshed after action
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(actionCode)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer gitlabSrv.Close()
+
+	svc := NewService(gitlab.NewService(gitlab.Settings{
+		URL:  gitlabSrv.URL,
+		HTTP: request.Settings{MaxRetries: -1},
+	}), nil)
+	svc.snapshot = &snapshot{projects: []Project{{ID: projectID, MergeRequests: []MergeRequest{{IID: iid}}}}}
+
+	actions := []struct {
+		name    string
+		do      func(ctx context.Context) (*MergeRequest, error)
+		request string
+	}{
+		{
+			name: "approve",
+			do: func(ctx context.Context) (*MergeRequest, error) {
+				return svc.Approve(ctx, projectID, iid)
+			},
+			request: "POST /api/v4/projects/1/merge_requests/2/approve",
+		},
+		{
+			name: "unapprove",
+			do: func(ctx context.Context) (*MergeRequest, error) {
+				return svc.Unapprove(ctx, projectID, iid)
+			},
+			request: "POST /api/v4/projects/1/merge_requests/2/unapprove",
+		},
+		{
+			name: "merge",
+			do: func(ctx context.Context) (*MergeRequest, error) {
+				return svc.Merge(ctx, projectID, iid, MergeOptions{Squash: true})
+			},
+			request: "PUT /api/v4/projects/1/merge_requests/2/merge",
+		},
+		{
+			name: "rebase",
+			do: func(ctx context.Context) (*MergeRequest, error) {
+				return svc.Rebase(ctx, projectID, iid)
+			},
+			request: "PUT /api/v4/projects/1/merge_requests/2/rebase",
+		},
+		{
+			name: "retry pipeline",
+			do: func(ctx context.Context) (*MergeRequest, error) {
+				return svc.RetryPipeline(ctx, projectID, iid, pipelineID)
+			},
+			request: "POST /api/v4/projects/1/pipelines/3/retry",
+		},
+		{
+			name: "retry job",
+			do: func(ctx context.Context) (*MergeRequest, error) {
+				return svc.RetryJob(ctx, projectID, iid, jobID)
+			},
+			request: "POST /api/v4/projects/1/jobs/4/retry",
+		},
+	}
+
+	tests := []struct {
+		name       string
+		actionCode int
+		wantErr    bool
+	}{
+		{
+			name:       "refresh failure does not fail done action",
+			actionCode: http.StatusCreated,
+			wantErr:    false,
+		},
+		{
+			name:       "action failure is returned",
+			actionCode: http.StatusUnauthorized,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		for _, action := range actions {
+			t.Run(tt.name+"/"+action.name, func(t *testing.T) {
+				mx.Lock()
+				requests = nil
+				actionCode = tt.actionCode
+				mx.Unlock()
+
+				res, err := action.do(context.Background())
+				if (err != nil) != tt.wantErr {
+					t.Errorf("%s error = %v, want error %v", action.name, err, tt.wantErr)
+				}
+				if res != nil {
+					t.Errorf("%s merge request = %+v, want nil", action.name, res)
+				}
+
+				mx.Lock()
+				defer mx.Unlock()
+				if !slices.Contains(requests, action.request) {
+					t.Errorf("%s requests = %v, want %q among them", action.name, requests, action.request)
+				}
+				if refreshed := len(requests) > 1; refreshed == tt.wantErr {
+					t.Errorf("%s requests = %v, refresh is expected after successful action only", action.name, requests)
+				}
+			})
+		}
+	}
+}
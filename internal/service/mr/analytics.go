@@ -0,0 +1,201 @@
+package mr
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/vlanse/glmr/internal/service/store"
+)
+
+// SizeBucket classifies merge requests by diff size (additions + deletions)
+type SizeBucket string
+
+const (
+	SizeXS SizeBucket = "xs" // less than 10 lines
+	SizeS  SizeBucket = "s"  // less than 50 lines
+	SizeM  SizeBucket = "m"  // less than 250 lines
+	SizeL  SizeBucket = "l"  // less than 1000 lines
+	SizeXL SizeBucket = "xl"
+)
+
+var sizeBuckets = []struct {
+	bucket   SizeBucket
+	maxLines int64
+}{
+	{SizeXS, 10},
+	{SizeS, 50},
+	{SizeM, 250},
+	{SizeL, 1000},
+}
+
+// SizeBuckets are all buckets from the smallest to the largest
+var SizeBuckets = []SizeBucket{SizeXS, SizeS, SizeM, SizeL, SizeXL}
+
+// AnalyticsMetrics are computed over merge requests recorded in history within time window
+type AnalyticsMetrics struct {
+	Opened int // merge requests created within window
+	Merged int // merge requests merged within window
+	// MedianTimeToFirstReview is measured for merge requests first reviewed within window, zero when there are none
+	MedianTimeToFirstReview time.Duration
+	// MedianTimeToMerge is measured for merge requests merged within window, zero when there are none
+	MedianTimeToMerge time.Duration
+	ReviewsGiven      int                // merge requests reviewed by person within window, set for people only
+	Sizes             map[SizeBucket]int // sizes of merge requests created within window
+}
+
+type NamedAnalyticsMetrics struct {
+	Name    string
+	Metrics AnalyticsMetrics
+}
+
+type Analytics struct {
+	From   time.Time
+	To     time.Time
+	Total  AnalyticsMetrics
+	Groups []NamedAnalyticsMetrics // metrics of merge requests of group projects, sorted by name
+	People []NamedAnalyticsMetrics // metrics of merge requests authored by person and reviews given, sorted by name
+}
+
+// GetAnalytics computes team review metrics over [from, to) from recorded history;
+// merge requests of projects which are not part of configured groups anymore count in totals only
+func (s *Service) GetAnalytics(ctx context.Context, from, to time.Time) (Analytics, error) {
+	records, err := s.storeSvc.GetAnalyticsRecords(ctx, from, to)
+	if err != nil {
+		return Analytics{}, err
+	}
+
+	groupsByProject := s.groupsByProject()
+
+	total := newMetricsBuilder()
+	groups := make(map[string]*metricsBuilder)
+	people := make(map[string]*metricsBuilder)
+	builder := func(m map[string]*metricsBuilder, name string) *metricsBuilder {
+		if m[name] == nil {
+			m[name] = newMetricsBuilder()
+		}
+		return m[name]
+	}
+
+	inWindow := func(t time.Time) bool {
+		return !t.IsZero() && !t.Before(from) && t.Before(to)
+	}
+
+	for _, r := range records {
+		builders := []*metricsBuilder{total, builder(people, r.MergeRequest.Author)}
+		for _, g := range groupsByProject[r.MergeRequest.ProjectID] {
+			builders = append(builders, builder(groups, g))
+		}
+
+		var firstReviewAt time.Time
+		if len(r.Reviews) > 0 {
+			firstReviewAt = lo.MinBy(r.Reviews, func(a, b store.Review) bool {
+				return a.ReviewedAt.Before(b.ReviewedAt)
+			}).ReviewedAt
+		}
+
+		for _, b := range builders {
+			if inWindow(r.MergeRequest.CreatedAt) {
+				b.opened++
+				b.sizes[sizeBucket(r.Additions+r.Deletions)]++
+			}
+			if inWindow(r.MergedAt) {
+				b.timesToMerge = append(b.timesToMerge, r.MergedAt.Sub(r.MergeRequest.CreatedAt))
+			}
+			if inWindow(firstReviewAt) {
+				b.timesToFirstReview = append(b.timesToFirstReview, firstReviewAt.Sub(r.MergeRequest.CreatedAt))
+			}
+		}
+
+		for _, review := range r.Reviews {
+			if inWindow(review.ReviewedAt) {
+				builder(people, review.Username).reviewsGiven++
+			}
+		}
+	}
+
+	return Analytics{
+		From:   from,
+		To:     to,
+		Total:  total.build(),
+		Groups: buildNamedMetrics(groups),
+		People: buildNamedMetrics(people),
+	}, nil
+}
+
+// groupsByProject maps projects of the latest snapshot to configured groups, dynamic groups are skipped
+// since their merge requests are not bound to projects
+func (s *Service) groupsByProject() map[int64][]string {
+	res := make(map[int64][]string)
+	snap := s.loadSnapshot()
+	if snap == nil {
+		return res
+	}
+
+	dynamicGroups := lo.SliceToMap(s.getSettings().DynamicGroups, func(item DynamicGroupSettings) (string, bool) {
+		return item.Name, true
+	})
+	for _, p := range snap.projects {
+		if p.ID != 0 && !dynamicGroups[p.GroupName] && !slices.Contains(res[p.ID], p.GroupName) {
+			res[p.ID] = append(res[p.ID], p.GroupName)
+		}
+	}
+	return res
+}
+
+type metricsBuilder struct {
+	opened             int
+	reviewsGiven       int
+	sizes              map[SizeBucket]int
+	timesToMerge       []time.Duration
+	timesToFirstReview []time.Duration
+}
+
+func newMetricsBuilder() *metricsBuilder {
+	return &metricsBuilder{sizes: make(map[SizeBucket]int)}
+}
+
+func (b *metricsBuilder) build() AnalyticsMetrics {
+	return AnalyticsMetrics{
+		Opened:                  b.opened,
+		Merged:                  len(b.timesToMerge),
+		MedianTimeToFirstReview: median(b.timesToFirstReview),
+		MedianTimeToMerge:       median(b.timesToMerge),
+		ReviewsGiven:            b.reviewsGiven,
+		Sizes:                   b.sizes,
+	}
+}
+
+func buildNamedMetrics(builders map[string]*metricsBuilder) []NamedAnalyticsMetrics {
+	res := lo.MapToSlice(builders, func(name string, b *metricsBuilder) NamedAnalyticsMetrics {
+		return NamedAnalyticsMetrics{Name: name, Metrics: b.build()}
+	})
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
+
+func sizeBucket(lines int64) SizeBucket {
+	for _, b := range sizeBuckets {
+		if lines < b.maxLines {
+			return b.bucket
+		}
+	}
+	return SizeXL
+}
+
+func median(values []time.Duration) time.Duration {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
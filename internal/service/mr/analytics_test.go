@@ -0,0 +1,205 @@
+package mr
+
+import (
+	"context"
+	"maps"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/vlanse/glmr/internal/service/store"
+)
+
+func TestGetAnalytics(t *testing.T) {
+	ctx := context.Background()
+	from := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	at := func(hours int) time.Time { return from.Add(time.Duration(hours) * time.Hour) }
+
+	storeSvc, err := store.NewService(ctx, filepath.Join(t.TempDir(), "glmr.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = storeSvc.Close() }()
+
+	svc := NewService(nil, storeSvc)
+	svc.settings = Settings{DynamicGroups: []DynamicGroupSettings{{Name: "mine"}}}
+	svc.snapshot = &snapshot{projects: []Project{
+		{ID: 1, GroupName: "backend"},
+		{ID: 1, GroupName: "platform"},
+		{ID: 2, GroupName: "frontend"},
+		{ID: 3, GroupName: "mine"},
+	}}
+
+	record := func(projectID int64, author string, createdAt time.Time, state string, recordedAt time.Time,
+		lines int64, reviews ...store.Review,
+	) store.Record {
+		return store.Record{
+			MergeRequest: store.MergeRequest{
+				MergeRequestKey: store.MergeRequestKey{ProjectID: projectID, IID: 1},
+				Author:          author,
+				CreatedAt:       createdAt,
+			},
+			State:   store.MergeRequestState{RecordedAt: recordedAt, State: state, ApprovedBy: []string{}, Additions: lines},
+			Reviews: reviews,
+		}
+	}
+	review := func(username string, reviewedAt time.Time) store.Review {
+		return store.Review{Username: username, ReviewedAt: reviewedAt}
+	}
+	merged := func(r store.Record) store.Record {
+		r.MergeRequest.MergedAt = r.State.RecordedAt
+		return r
+	}
+
+	for _, batch := range [][]store.Record{
+		{
+			// opened before window and first reviewed before window, merged within window
+			record(2, "alice", at(-48), openedState, at(-48), 100, review("bob", at(-24))),
+		},
+		{
+			// opened, reviewed and merged within window
+			record(1, "alice", at(1), openedState, at(1), 5, review("bob", at(3)), review("carol", at(5))),
+			// opened within window in project of dynamic group only
+			record(3, "bob", at(2), openedState, at(2), 300),
+			// opened after window
+			record(4, "dave", to.Add(time.Hour), openedState, to.Add(time.Hour), 5),
+		},
+		{
+			merged(record(2, "alice", at(-48), mergedState, at(2), 100)),
+			merged(record(1, "alice", at(1), mergedState, at(9), 5)),
+		},
+	} {
+		if err = storeSvc.RecordStates(ctx, batch); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := svc.GetAnalytics(ctx, from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equal := func(a, b AnalyticsMetrics) bool {
+		return a.Opened == b.Opened && a.Merged == b.Merged && a.MedianTimeToFirstReview == b.MedianTimeToFirstReview &&
+			a.MedianTimeToMerge == b.MedianTimeToMerge && a.ReviewsGiven == b.ReviewsGiven && maps.Equal(a.Sizes, b.Sizes)
+	}
+	namedEqual := func(a, b NamedAnalyticsMetrics) bool {
+		return a.Name == b.Name && equal(a.Metrics, b.Metrics)
+	}
+
+	wantTotal := AnalyticsMetrics{
+		Opened:                  2,
+		Merged:                  2,
+		MedianTimeToFirstReview: 2 * time.Hour,
+		MedianTimeToMerge:       29 * time.Hour,
+		Sizes:                   map[SizeBucket]int{SizeXS: 1, SizeL: 1},
+	}
+	if !equal(got.Total, wantTotal) {
+		t.Errorf("total = %+v, want %+v", got.Total, wantTotal)
+	}
+
+	backend := AnalyticsMetrics{
+		Opened:                  1,
+		Merged:                  1,
+		MedianTimeToFirstReview: 2 * time.Hour,
+		MedianTimeToMerge:       8 * time.Hour,
+		Sizes:                   map[SizeBucket]int{SizeXS: 1},
+	}
+	wantGroups := []NamedAnalyticsMetrics{
+		{Name: "backend", Metrics: backend},
+		{Name: "frontend", Metrics: AnalyticsMetrics{Merged: 1, MedianTimeToMerge: 50 * time.Hour}},
+		{Name: "platform", Metrics: backend},
+	}
+	if !slices.EqualFunc(got.Groups, wantGroups, namedEqual) {
+		t.Errorf("groups = %+v, want %+v", got.Groups, wantGroups)
+	}
+
+	wantPeople := []NamedAnalyticsMetrics{
+		{Name: "alice", Metrics: AnalyticsMetrics{
+			Opened:                  1,
+			Merged:                  2,
+			MedianTimeToFirstReview: 2 * time.Hour,
+			MedianTimeToMerge:       29 * time.Hour,
+			Sizes:                   map[SizeBucket]int{SizeXS: 1},
+		}},
+		{Name: "bob", Metrics: AnalyticsMetrics{Opened: 1, ReviewsGiven: 1, Sizes: map[SizeBucket]int{SizeL: 1}}},
+		{Name: "carol", Metrics: AnalyticsMetrics{ReviewsGiven: 1}},
+	}
+	if !slices.EqualFunc(got.People, wantPeople, namedEqual) {
+		t.Errorf("people = %+v, want %+v", got.People, wantPeople)
+	}
+}
+
+func TestSizeBucket(t *testing.T) {
+	tests := []struct {
+		lines int64
+		want  SizeBucket
+	}{
+		{lines: 0, want: SizeXS},
+		{lines: 9, want: SizeXS},
+		{lines: 10, want: SizeS},
+		{lines: 49, want: SizeS},
+		{lines: 50, want: SizeM},
+		{lines: 249, want: SizeM},
+		{lines: 250, want: SizeL},
+		{lines: 999, want: SizeL},
+		{lines: 1000, want: SizeXL},
+		{lines: 100000, want: SizeXL},
+	}
+
+	for _, tt := range tests {
+		if got := sizeBucket(tt.lines); got != tt.want {
+			t.Errorf("sizeBucket(%d) = %q, want %q", tt.lines, got, tt.want)
+		}
+	}
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []time.Duration
+		want   time.Duration
+	}{
+		{
+			name:   "no values",
+			values: nil,
+			want:   0,
+		},
+		{
+			name:   "single value",
+			values: []time.Duration{time.Hour},
+			want:   time.Hour,
+		},
+		{
+			name:   "odd number of values",
+			values: []time.Duration{5 * time.Hour, time.Hour, 3 * time.Hour},
+			want:   3 * time.Hour,
+		},
+		{
+			name:   "even number of values",
+			values: []time.Duration{4 * time.Hour, time.Hour, 2 * time.Hour, 10 * time.Hour},
+			want:   3 * time.Hour,
+		},
+		{
+			name:   "outlier does not shift median",
+			values: []time.Duration{time.Minute, 2 * time.Minute, 3 * time.Minute, 1000 * time.Hour},
+			want:   150 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := append([]time.Duration(nil), tt.values...)
+			if got := median(tt.values); got != tt.want {
+				t.Errorf("median() = %v, want %v", got, tt.want)
+			}
+			for i := range values {
+				if values[i] != tt.values[i] {
+					t.Fatalf("median() modified input: %v, was %v", tt.values, values)
+				}
+			}
+		})
+	}
+}
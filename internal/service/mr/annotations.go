@@ -0,0 +1,54 @@
+package mr
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/vlanse/glmr/internal/service/store"
+)
+
+// SetPrivateNote saves markdown note of merge request locally, empty note deletes it; note can be attached
+// to any merge request, returned merge request is nil when it is not shown on dashboard
+func (s *Service) SetPrivateNote(ctx context.Context, projectID, mergeRequestIID int64, note string) (*MergeRequest, error) {
+	return s.updateAnnotation(ctx, projectID, mergeRequestIID, func(a store.Annotation) store.Annotation {
+		a.Note = strings.TrimSpace(note)
+		return a
+	})
+}
+
+// SetTags replaces tags of merge request, tags are trimmed, deduplicated and sorted
+func (s *Service) SetTags(ctx context.Context, projectID, mergeRequestIID int64, tags []string) (*MergeRequest, error) {
+	return s.updateAnnotation(ctx, projectID, mergeRequestIID, func(a store.Annotation) store.Annotation {
+		a.Tags = normalizeTags(tags)
+		return a
+	})
+}
+
+func (s *Service) updateAnnotation(
+	ctx context.Context, projectID, mergeRequestIID int64, update func(store.Annotation) store.Annotation,
+) (*MergeRequest, error) {
+	now := time.Now()
+	if err := s.storeSvc.UpdateAnnotation(
+		ctx, store.MergeRequestKey{ProjectID: projectID, IID: mergeRequestIID}, now, update,
+	); err != nil {
+		return nil, err
+	}
+
+	mr := s.findMergeRequest(projectID, mergeRequestIID)
+	if mr == nil {
+		return nil, nil
+	}
+	return lo.ToPtr(s.withLocalState(ctx, now, *mr)), nil
+}
+
+func normalizeTags(tags []string) []string {
+	res := lo.Uniq(lo.FilterMap(tags, func(item string, _ int) (string, bool) {
+		item = strings.TrimSpace(item)
+		return item, len(item) > 0
+	}))
+	slices.Sort(res)
+	return res
+}
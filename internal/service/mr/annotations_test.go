@@ -0,0 +1,143 @@
+package mr
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/vlanse/glmr/internal/service/store"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tests := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{name: "no tags", tags: nil, want: []string{}},
+		{name: "sorted", tags: []string{"later", "backend"}, want: []string{"backend", "later"}},
+		{name: "trimmed", tags: []string{" later ", "\tbackend\n"}, want: []string{"backend", "later"}},
+		{name: "deduplicated after trimming", tags: []string{"later", " later", "later "}, want: []string{"later"}},
+		{name: "blank are dropped", tags: []string{"", "  ", "later"}, want: []string{"later"}},
+		{name: "case is kept", tags: []string{"Later", "later"}, want: []string{"Later", "later"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeTags(tt.tags); !slices.Equal(got, tt.want) {
+				t.Errorf("normalizeTags(%q) = %q, want %q", tt.tags, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnnotations(t *testing.T) {
+	const (
+		projectID = 1
+		shown     = 1
+		notShown  = 2
+	)
+
+	type step struct {
+		note *string
+		tags []string
+	}
+	note := func(s string) step { return step{note: &s} }
+	tags := func(tags ...string) step { return step{tags: tags} }
+
+	tests := []struct {
+		name     string
+		iid      int64
+		steps    []step
+		wantNote string
+		wantTags []string
+		wantNone bool
+	}{
+		{
+			name:     "note is trimmed",
+			iid:      shown,
+			steps:    []step{note("  check **later**\n")},
+			wantNote: "check **later**",
+		},
+		{
+			name:     "tags are normalized",
+			iid:      shown,
+			steps:    []step{tags("later ", "backend", "later")},
+			wantTags: []string{"backend", "later"},
+		},
+		{
+			name:     "empty note deletes it, tags are kept",
+			iid:      shown,
+			steps:    []step{note("check"), tags("later"), note("")},
+			wantTags: []string{"later"},
+		},
+		{
+			name:     "blank note deletes it",
+			iid:      shown,
+			steps:    []step{note("check"), note(" \n ")},
+			wantNone: true,
+		},
+		{
+			name:     "empty tags delete them",
+			iid:      shown,
+			steps:    []step{note("check"), tags("later"), tags(" ")},
+			wantNote: "check",
+		},
+		{
+			name:     "merge request which is not shown can be annotated",
+			iid:      notShown,
+			steps:    []step{note("check"), tags("later")},
+			wantNote: "check",
+			wantTags: []string{"later"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			storeSvc, err := store.NewService(ctx, filepath.Join(t.TempDir(), "glmr.db"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer func() { _ = storeSvc.Close() }()
+
+			svc := NewService(nil, storeSvc)
+			svc.snapshot = &snapshot{projects: []Project{{
+				ID:            projectID,
+				MergeRequests: []MergeRequest{{IID: shown, Project: Project{ID: projectID}}},
+			}}}
+
+			var res *MergeRequest
+			for _, st := range tt.steps {
+				if st.note != nil {
+					res, err = svc.SetPrivateNote(ctx, projectID, tt.iid, *st.note)
+				} else {
+					res, err = svc.SetTags(ctx, projectID, tt.iid, st.tags)
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if (res != nil) != (tt.iid == shown) {
+				t.Fatalf("returned merge request = %+v, want it for shown merge request only", res)
+			}
+			if res != nil && (res.PrivateNote != tt.wantNote || !slices.Equal(res.Tags, tt.wantTags)) {
+				t.Errorf("returned note = %q, tags = %q, want %q, %q", res.PrivateNote, res.Tags, tt.wantNote, tt.wantTags)
+			}
+
+			annotations, err := storeSvc.GetAnnotations(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			a, found := annotations[store.MergeRequestKey{ProjectID: projectID, IID: tt.iid}]
+			if found == tt.wantNone {
+				t.Fatalf("stored annotation = %+v, found %v, want found %v", a, found, !tt.wantNone)
+			}
+			if a.Note != tt.wantNote || !slices.Equal(a.Tags, tt.wantTags) {
+				t.Errorf("stored note = %q, tags = %q, want %q, %q", a.Note, a.Tags, tt.wantNote, tt.wantTags)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package mr
+
+import "time"
+
+const dateLayout = "2006-01-02"
+
+// CalendarSettings defines working time which SLA are measured in
+type CalendarSettings struct {
+	// WorkingDays are all days of week when empty
+	WorkingDays []time.Weekday
+	// Holidays are non-working dates, only year, month and day are taken into account
+	Holidays []time.Time
+}
+
+type calendar struct {
+	workingDays map[time.Weekday]bool
+	holidays    map[string]bool
+}
+
+func newCalendar(settings CalendarSettings) calendar {
+	c := calendar{
+		workingDays: make(map[time.Weekday]bool, len(settings.WorkingDays)),
+		holidays:    make(map[string]bool, len(settings.Holidays)),
+	}
+	for _, d := range settings.WorkingDays {
+		c.workingDays[d] = true
+	}
+	for _, d := range settings.Holidays {
+		c.holidays[d.Format(dateLayout)] = true
+	}
+	return c
+}
+
+func (c calendar) isWorkingDay(t time.Time) bool {
+	if len(c.workingDays) > 0 && !c.workingDays[t.Weekday()] {
+		return false
+	}
+	return !c.holidays[t.Format(dateLayout)]
+}
+
+// workingTime returns duration between from and to excluding non-working days, days are in local time zone
+func (c calendar) workingTime(from, to time.Time) time.Duration {
+	from, to = from.Local(), to.Local()
+
+	var res time.Duration
+	for from.Before(to) {
+		y, m, d := from.Date()
+		nextDay := time.Date(y, m, d+1, 0, 0, 0, 0, time.Local)
+		end := nextDay
+		if to.Before(end) {
+			end = to
+		}
+		if c.isWorkingDay(from) {
+			res += end.Sub(from)
+		}
+		from = nextDay
+	}
+	return res
+}
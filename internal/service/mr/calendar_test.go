@@ -0,0 +1,150 @@
+package mr
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestCalendarWorkingTime(t *testing.T) {
+	// days are split in local time zone, the one with daylight saving time is used
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	local := time.Local
+	time.Local = loc
+	defer func() { time.Local = local }()
+
+	at := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2026, month, day, hour, minute, 0, 0, loc)
+	}
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+	tests := []struct {
+		name     string
+		settings CalendarSettings
+		from     time.Time
+		to       time.Time
+		want     time.Duration
+	}{
+		{
+			name: "within one day",
+			from: at(time.March, 2, 10, 0),
+			to:   at(time.March, 2, 15, 30),
+			want: 5*time.Hour + 30*time.Minute,
+		},
+		{
+			name: "to before from",
+			from: at(time.March, 3, 10, 0),
+			to:   at(time.March, 2, 10, 0),
+			want: 0,
+		},
+		{
+			name: "all days are working without settings",
+			from: at(time.March, 6, 12, 0),
+			to:   at(time.March, 9, 12, 0),
+			want: 72 * time.Hour,
+		},
+		{
+			name: "weekend is skipped",
+			settings: CalendarSettings{
+				WorkingDays: weekdays,
+			},
+			from: at(time.March, 6, 12, 0),
+			to:   at(time.March, 9, 12, 0),
+			want: 24 * time.Hour,
+		},
+		{
+			name: "wait started on weekend",
+			settings: CalendarSettings{
+				WorkingDays: weekdays,
+			},
+			from: at(time.March, 7, 12, 0),
+			to:   at(time.March, 9, 9, 0),
+			want: 9 * time.Hour,
+		},
+		{
+			name: "day with clocks set forward is shorter",
+			from: at(time.March, 29, 0, 0),
+			to:   at(time.March, 30, 0, 0),
+			want: 23 * time.Hour,
+		},
+		{
+			name: "across clocks set forward",
+			from: at(time.March, 28, 12, 0),
+			to:   at(time.March, 30, 12, 0),
+			want: 47 * time.Hour,
+		},
+		{
+			name: "across clocks set back",
+			from: at(time.October, 24, 12, 0),
+			to:   at(time.October, 26, 12, 0),
+			want: 49 * time.Hour,
+		},
+		{
+			name: "clocks change on non-working day",
+			settings: CalendarSettings{
+				WorkingDays: weekdays,
+			},
+			from: at(time.October, 23, 12, 0),
+			to:   at(time.October, 26, 12, 0),
+			want: 24 * time.Hour,
+		},
+		{
+			name: "holiday is skipped",
+			settings: CalendarSettings{
+				WorkingDays: weekdays,
+				Holidays:    []time.Time{at(time.March, 4, 0, 0)},
+			},
+			from: at(time.March, 3, 12, 0),
+			to:   at(time.March, 5, 12, 0),
+			want: 24 * time.Hour,
+		},
+		{
+			name: "wait ends at holiday midnight",
+			settings: CalendarSettings{
+				Holidays: []time.Time{at(time.March, 4, 0, 0)},
+			},
+			from: at(time.March, 3, 12, 0),
+			to:   at(time.March, 4, 0, 0),
+			want: 12 * time.Hour,
+		},
+		{
+			name: "wait starts on holiday",
+			settings: CalendarSettings{
+				Holidays: []time.Time{at(time.March, 4, 0, 0)},
+			},
+			from: at(time.March, 4, 10, 0),
+			to:   at(time.March, 5, 10, 0),
+			want: 10 * time.Hour,
+		},
+		{
+			name: "time of holiday is ignored",
+			settings: CalendarSettings{
+				Holidays: []time.Time{at(time.March, 4, 18, 30)},
+			},
+			from: at(time.March, 4, 10, 0),
+			to:   at(time.March, 4, 12, 0),
+			want: 0,
+		},
+		{
+			name: "times in other zone are split by local days",
+			settings: CalendarSettings{
+				WorkingDays: weekdays,
+			},
+			// friday 23:30 UTC is already saturday in local time zone
+			from: time.Date(2026, time.March, 6, 23, 30, 0, 0, time.UTC),
+			to:   time.Date(2026, time.March, 7, 5, 0, 0, 0, time.UTC),
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newCalendar(tt.settings).workingTime(tt.from, tt.to); got != tt.want {
+				t.Errorf("workingTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package mr
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// codeOwnersPaths are locations of CODEOWNERS file in repository in the order gitlab looks them up
+var codeOwnersPaths = []string{"CODEOWNERS", ".gitlab/CODEOWNERS", "docs/CODEOWNERS"}
+
+type codeOwnersEntry struct {
+	pattern *regexp.Regexp
+	owners  []string // usernames, emails are not supported
+}
+
+// codeOwners is parsed CODEOWNERS file, the last matching entry wins like in gitlab
+type codeOwners []codeOwnersEntry
+
+func parseCodeOwners(data []byte) codeOwners {
+	var res codeOwners
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		// sections are treated as a single one, their default owners are not supported
+		if len(line) == 0 || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "[") || strings.HasPrefix(line, "^[") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		owners := lo.FilterMap(fields[1:], func(item string, _ int) (string, bool) {
+			return strings.TrimPrefix(item, "@"), strings.HasPrefix(item, "@")
+		})
+		if len(owners) == 0 {
+			continue
+		}
+		res = append(res, codeOwnersEntry{pattern: codeOwnersPattern(fields[0]), owners: owners})
+	}
+	return res
+}
+
+// owners returns owners of files, files without matching entry are ignored
+func (c codeOwners) owners(files []string) []string {
+	var res []string
+	for _, f := range files {
+		for i := len(c) - 1; i >= 0; i-- {
+			if c[i].pattern.MatchString(f) {
+				res = append(res, c[i].owners...)
+				break
+			}
+		}
+	}
+	return lo.Uniq(res)
+}
+
+// codeOwnersPattern converts gitignore-like pattern to regexp: leading slash anchors pattern to repository root,
+// trailing slash matches directory content, "*" matches within path segment and "**" matches any path,
+// "a/**/b" matches "a/b" as well
+func codeOwnersPattern(pattern string) *regexp.Regexp {
+	anchored := strings.HasPrefix(pattern, "/")
+	directory := strings.HasSuffix(pattern, "/")
+	pattern = strings.Trim(pattern, "/")
+
+	var rx strings.Builder
+	rx.WriteString("^")
+	if !anchored {
+		rx.WriteString("(.*/)?")
+	}
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			rx.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			rx.WriteString(".*")
+			i++
+		case pattern[i] == '*':
+			rx.WriteString("[^/]*")
+		case pattern[i] == '?':
+			rx.WriteString("[^/]")
+		default:
+			rx.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	if directory {
+		rx.WriteString("/.*$")
+	} else {
+		rx.WriteString("(/.*)?$")
+	}
+	return regexp.MustCompile(rx.String())
+}
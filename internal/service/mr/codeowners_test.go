@@ -0,0 +1,139 @@
+package mr
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCodeOwnersPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		match   []string
+		noMatch []string
+	}{
+		{
+			pattern: "README.md",
+			match:   []string{"README.md", "docs/README.md", "a/b/README.md"},
+			noMatch: []string{"README.md.bak", "OLD_README.md"},
+		},
+		{
+			pattern: "/README.md",
+			match:   []string{"README.md"},
+			noMatch: []string{"docs/README.md"},
+		},
+		{
+			pattern: "*.go",
+			match:   []string{"main.go", "cmd/glmr/main.go"},
+			noMatch: []string{"main.go.orig", "go.mod"},
+		},
+		{
+			pattern: "/internal/*.go",
+			match:   []string{"internal/a.go"},
+			noMatch: []string{"internal/mr/a.go", "pkg/internal/a.go"},
+		},
+		{
+			pattern: "docs/",
+			match:   []string{"docs/index.md", "web/docs/api/index.md"},
+			noMatch: []string{"docs", "documents/index.md"},
+		},
+		{
+			pattern: "/docs/",
+			match:   []string{"docs/index.md", "docs/api/index.md"},
+			noMatch: []string{"web/docs/index.md", "docs"},
+		},
+		{
+			pattern: "/internal",
+			match:   []string{"internal", "internal/mr/service.go"},
+			noMatch: []string{"internals/a.go", "cmd/internal/a.go"},
+		},
+		{
+			pattern: "/internal/**/*.go",
+			match:   []string{"internal/a.go", "internal/service/mr/a.go"},
+			noMatch: []string{"internal/a.md", "cmd/internal/a.go"},
+		},
+		{
+			pattern: "/web/**",
+			match:   []string{"web/index.html", "web/src/app.ts"},
+			noMatch: []string{"website/index.html"},
+		},
+		{
+			pattern: "/file?.txt",
+			match:   []string{"file1.txt"},
+			noMatch: []string{"file.txt", "file/.txt", "file12.txt"},
+		},
+		{
+			pattern: "/v1.0/a+b.txt",
+			match:   []string{"v1.0/a+b.txt"},
+			noMatch: []string{"v1x0/a+b.txt", "v1.0/aab.txt"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			rx := codeOwnersPattern(tt.pattern)
+			for _, path := range tt.match {
+				if !rx.MatchString(path) {
+					t.Errorf("codeOwnersPattern(%q) does not match %q, want match", tt.pattern, path)
+				}
+			}
+			for _, path := range tt.noMatch {
+				if rx.MatchString(path) {
+					t.Errorf("codeOwnersPattern(%q) matches %q, want no match", tt.pattern, path)
+				}
+			}
+		})
+	}
+}
+
+func TestCodeOwnersOwners(t *testing.T) {
+	owners := parseCodeOwners([]byte(`
+# comment
+* @alice
+*.go @bob
+/docs/ @carol @dave
+/docs/api/ @erin user@example.com
+[Section]
+/web/ @frank
+/no/owners
+`))
+
+	tests := []struct {
+		name  string
+		files []string
+		want  []string
+	}{
+		{
+			name:  "catch-all entry",
+			files: []string{"Makefile"},
+			want:  []string{"alice"},
+		},
+		{
+			name:  "the last matching entry wins",
+			files: []string{"cmd/main.go", "docs/api/index.md"},
+			want:  []string{"bob", "erin"},
+		},
+		{
+			name:  "owners are unique",
+			files: []string{"a.go", "b.go", "docs/index.md"},
+			want:  []string{"bob", "carol", "dave"},
+		},
+		{
+			name:  "entries from sections are used",
+			files: []string{"web/app.ts"},
+			want:  []string{"frank"},
+		},
+		{
+			name:  "entry without owners is skipped",
+			files: []string{"no/owners"},
+			want:  []string{"alice"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := owners.owners(tt.files); !slices.Equal(got, tt.want) {
+				t.Errorf("owners() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package mr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+// backgroundRefreshTimeout limits refresh of merge request after it was commented
+const backgroundRefreshTimeout = time.Minute
+
+// GetDiscussions returns all threads of merge request with full notes, they are fetched from gitlab directly
+func (s *Service) GetDiscussions(ctx context.Context, projectID, mergeRequestIID int64) ([]Discussion, error) {
+	if _, err := s.getCurrentUserName(ctx); err != nil {
+		return nil, err
+	}
+
+	discussions, err := s.gitlabSvc.GetMergeRequestDiscussions(ctx, projectID, mergeRequestIID)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(discussions, s.discussionFromGitlab), nil
+}
+
+// Comment starts new thread on merge request
+func (s *Service) Comment(ctx context.Context, projectID, mergeRequestIID int64, body string) (Discussion, error) {
+	if _, err := s.getCurrentUserName(ctx); err != nil {
+		return Discussion{}, err
+	}
+
+	discussion, err := s.gitlabSvc.CreateMergeRequestDiscussion(ctx, projectID, mergeRequestIID, body)
+	if err != nil {
+		return Discussion{}, err
+	}
+
+	s.refreshMergeRequestInBackground(projectID, mergeRequestIID)
+	return s.discussionFromGitlab(discussion, 0), nil
+}
+
+// ReplyToDiscussion adds note to the thread and returns the whole thread
+func (s *Service) ReplyToDiscussion(
+	ctx context.Context, projectID, mergeRequestIID int64, discussionID, body string,
+) (Discussion, error) {
+	if _, err := s.getCurrentUserName(ctx); err != nil {
+		return Discussion{}, err
+	}
+
+	if _, err := s.gitlabSvc.ReplyToMergeRequestDiscussion(ctx, projectID, mergeRequestIID, discussionID, body); err != nil {
+		return Discussion{}, err
+	}
+
+	s.refreshMergeRequestInBackground(projectID, mergeRequestIID)
+
+	discussion, err := s.gitlabSvc.GetMergeRequestDiscussion(ctx, projectID, mergeRequestIID, discussionID)
+	if err != nil {
+		return Discussion{}, fmt.Errorf("reply is added, but thread could not be fetched: %w", err)
+	}
+	return s.discussionFromGitlab(discussion, 0), nil
+}
+
+// ResolveDiscussion resolves or unresolves the thread
+func (s *Service) ResolveDiscussion(
+	ctx context.Context, projectID, mergeRequestIID int64, discussionID string, resolved bool,
+) (Discussion, error) {
+	if _, err := s.getCurrentUserName(ctx); err != nil {
+		return Discussion{}, err
+	}
+
+	discussion, err := s.gitlabSvc.ResolveMergeRequestDiscussion(ctx, projectID, mergeRequestIID, discussionID, resolved)
+	if err != nil {
+		return Discussion{}, err
+	}
+
+	s.refreshMergeRequestInBackground(projectID, mergeRequestIID)
+	return s.discussionFromGitlab(discussion, 0), nil
+}
+
+// refreshMergeRequestInBackground updates comment stats of merge request in snapshot without delaying the caller;
+// refresh waits for tasks of the pool, so it must not run on the pool itself
+func (s *Service) refreshMergeRequestInBackground(projectID, mergeRequestIID int64) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), backgroundRefreshTimeout)
+		defer cancel()
+		if _, err := s.refreshMergeRequest(ctx, projectID, mergeRequestIID); err != nil {
+			log.Printf("could not refresh merge request %d of project %d: %v", mergeRequestIID, projectID, err)
+		}
+	}()
+}
@@ -0,0 +1,138 @@
+package mr
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/samber/lo"
+	"github.com/vlanse/glmr/internal/service/gitlab"
+)
+
+var dynamicGroupConnections = map[DynamicGroupType]gitlab.CurrentUserMergeRequests{
+	DynamicGroupReviewRequested: gitlab.ReviewRequestedMergeRequests,
+	DynamicGroupAssigned:        gitlab.AssignedMergeRequests,
+	DynamicGroupAuthored:        gitlab.AuthoredMergeRequests,
+}
+
+// collectDynamicGroups fetches merge requests of dynamic groups and returns them as projects of these groups,
+// group which could not be fetched is returned as a project with Error set
+func (s *Service) collectDynamicGroups(ctx context.Context, settings Settings) []Project {
+	groups := settings.DynamicGroups
+
+	var mx sync.Mutex
+	mrsByGroup := make(map[string][]gitlab.MergeRequestGQ, len(groups))
+	groupErrs := make(map[string]error)
+
+	group := s.pool.NewGroup()
+	for _, g := range groups {
+		connection, found := dynamicGroupConnections[g.Type]
+		if !found {
+			mx.Lock()
+			groupErrs[g.Name] = fmt.Errorf("unknown type %q of dynamic group %s", g.Type, g.Name)
+			mx.Unlock()
+			continue
+		}
+		group.Submit(
+			func() {
+				mrs, err := s.gitlabSvc.GetCurrentUserMergeRequestsGQ(ctx, connection)
+				mx.Lock()
+				defer mx.Unlock()
+				if err != nil {
+					groupErrs[g.Name] = err
+					return
+				}
+				mrsByGroup[g.Name] = mrs
+			},
+		)
+	}
+	_ = group.Wait()
+
+	var (
+		projects []Project
+		// approval state is not available via GraphQL for these projects, fallback to REST API
+		noRulesProjectIDs []int64
+	)
+	for _, g := range groups {
+		if err, found := groupErrs[g.Name]; found {
+			projects = append(projects, Project{
+				Name:      g.Name,
+				GroupName: g.Name,
+				Error:     newProjectError(err),
+			})
+			continue
+		}
+
+		mrs := lo.Filter(mrsByGroup[g.Name], func(item gitlab.MergeRequestGQ, _ int) bool {
+			return item.Project != nil
+		})
+		for _, projectMRs := range lo.PartitionBy(mrs, func(item gitlab.MergeRequestGQ) int64 {
+			return item.ProjectID
+		}) {
+			projectGQ := projectMRs[0].Project
+			p := Project{
+				ID:                projectMRs[0].ProjectID,
+				Name:              projectGQ.Name,
+				GroupName:         g.Name,
+				WebURL:            projectGQ.WebURL,
+				PathWithNamespace: projectGQ.FullPath,
+				sla: settings.defaultSLA().overriddenBy(g.SLA).
+					overriddenBy(settings.projectSLA(projectMRs[0].ProjectID)),
+			}
+			p.MergeRequests = lo.Map(projectMRs, func(mr gitlab.MergeRequestGQ, _ int) MergeRequest {
+				return s.mergeRequestFromGQ(p, mr)
+			})
+			p.ApprovalRules = lo.Map(
+				approvalRulesFromMergeRequests(projectMRs), func(r gitlab.ApprovalRule, _ int) ApprovalRule {
+					return s.approvalRuleFromGitlab(r)
+				},
+			)
+			projects = append(projects, p)
+
+			if lo.SomeBy(projectMRs, func(item gitlab.MergeRequestGQ) bool {
+				return item.ApprovalState == nil
+			}) {
+				noRulesProjectIDs = append(noRulesProjectIDs, p.ID)
+			}
+		}
+	}
+
+	return s.enrichApprovalRules(ctx, projects, lo.Uniq(noRulesProjectIDs))
+}
+
+// enrichApprovalRules requests approval rules of given projects with REST API
+func (s *Service) enrichApprovalRules(ctx context.Context, projects []Project, projectIDs []int64) []Project {
+	var mx sync.Mutex
+	rulesByProject := make(map[int64][]gitlab.ApprovalRule)
+	projectErrs := make(map[int64]error)
+
+	group := s.pool.NewGroup()
+	for _, projectID := range projectIDs {
+		group.Submit(
+			func() {
+				rules, err := s.gitlabSvc.GetApprovalRules(ctx, projectID)
+				mx.Lock()
+				defer mx.Unlock()
+				if err != nil {
+					projectErrs[projectID] = err
+					return
+				}
+				rulesByProject[projectID] = rules
+			},
+		)
+	}
+	_ = group.Wait()
+
+	for i, p := range projects {
+		if err, found := projectErrs[p.ID]; found && p.Error == nil {
+			projects[i].Error = newProjectError(err)
+			continue
+		}
+		if rules, found := rulesByProject[p.ID]; found {
+			projects[i].ApprovalRules = lo.Map(rules, func(r gitlab.ApprovalRule, _ int) ApprovalRule {
+				return s.approvalRuleFromGitlab(r)
+			})
+		}
+	}
+	return projects
+}
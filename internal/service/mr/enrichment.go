@@ -2,200 +2,560 @@ package mr
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/vlanse/glmr/internal/service/gitlab"
 )
 
-func (s *Service) enrichProjectMRDiscussions(ctx context.Context, projects []Project) ([]Project, error) {
+func (s *Service) enrichProjectMRDiscussions(ctx context.Context, projects []Project) []Project {
 	var mx sync.Mutex
 	discussionsByMR := make(map[int64]map[int64][]gitlab.Discussion, len(projects))
+	mrErrs := make(map[mergeRequestKey]error)
 
 	group := s.pool.NewGroup()
 	for _, project := range projects {
 		for _, mr := range project.MergeRequests {
-			group.SubmitErr(
-				func() error {
+			if mr.discussionsLoaded {
+				continue
+			}
+			group.Submit(
+				func() {
 					discussions, err := s.gitlabSvc.GetMergeRequestDiscussions(ctx, project.ID, mr.IID)
-					if err != nil {
-						return err
-					}
 					mx.Lock()
 					defer mx.Unlock()
+					if err != nil {
+						mrErrs[mergeRequestKey{projectID: project.ID, iid: mr.IID}] = fmt.Errorf("get discussions: %w", err)
+						return
+					}
 					discussionsByMR[project.ID] = lo.Assign(
 						discussionsByMR[project.ID], map[int64][]gitlab.Discussion{mr.IID: discussions},
 					)
-					return nil
 				},
 			)
 		}
 	}
 
-	if err := group.Wait(); err != nil {
-		return nil, fmt.Errorf("enrich merge requests from gitlab: %w", err)
-	}
+	_ = group.Wait()
 
 	for i, p := range projects {
 		for j, mr := range projects[i].MergeRequests {
-			projects[i].MergeRequests[j].Discussions = lo.Map(
-				discussionsByMR[p.ID][mr.IID], func(d gitlab.Discussion, _ int) Discussion {
-					return Discussion{
-						Notes: lo.Map(d.Notes, func(item gitlab.Note, _ int) Note {
-							return Note{
-								Author: User{
-									Username:  item.Author.Username,
-									AvatarURL: item.Author.AvatarURL,
-									WebURL:    item.Author.WebURL,
-									IsMe:      s.currentUser.Username == item.Author.Username,
-								},
-								ResolvedBy: User{
-									Username:  item.ResolvedBy.Username,
-									AvatarURL: item.ResolvedBy.AvatarURL,
-									WebURL:    item.ResolvedBy.WebURL,
-									IsMe:      s.currentUser.Username == item.ResolvedBy.Username,
-								},
-								Resolved:   item.Resolved,
-								Resolvable: item.Resolvable,
-								CreatedAt:  item.CreatedAt,
-								ResolveAt:  item.ResolvedAt,
-								Body:       item.Body,
-							}
-						}),
+			if mr.discussionsLoaded {
+				continue
+			}
+			if err, found := mrErrs[mergeRequestKey{projectID: p.ID, iid: mr.IID}]; found {
+				projects[i].MergeRequests[j].Warnings = append(projects[i].MergeRequests[j].Warnings, err.Error())
+				continue
+			}
+			projects[i].MergeRequests[j].Discussions = lo.Map(discussionsByMR[p.ID][mr.IID], s.discussionFromGitlab)
+			projects[i].MergeRequests[j].discussionsLoaded = true
+		}
+	}
+
+	return projects
+}
+
+// enrichPipelineJobs requests failed jobs of failed pipelines
+func (s *Service) enrichPipelineJobs(ctx context.Context, projects []Project) []Project {
+	var mx sync.Mutex
+	jobsByPipeline := make(map[int64][]gitlab.Job)
+	mrErrs := make(map[mergeRequestKey]error)
+
+	group := s.pool.NewGroup()
+	for _, project := range projects {
+		for _, mr := range project.MergeRequests {
+			if mr.Pipeline.ID == 0 || mr.Pipeline.Status != pipelineFailedStatus {
+				continue
+			}
+			group.Submit(
+				func() {
+					jobs, err := s.gitlabSvc.GetPipelineFailedJobs(ctx, project.ID, mr.Pipeline.ID)
+					mx.Lock()
+					defer mx.Unlock()
+					if err != nil {
+						mrErrs[mergeRequestKey{projectID: project.ID, iid: mr.IID}] = fmt.Errorf("get failed jobs: %w", err)
+						return
+					}
+					jobsByPipeline[mr.Pipeline.ID] = jobs
+				},
+			)
+		}
+	}
+
+	_ = group.Wait()
+
+	for i, p := range projects {
+		for j, mr := range p.MergeRequests {
+			if err, found := mrErrs[mergeRequestKey{projectID: p.ID, iid: mr.IID}]; found {
+				projects[i].MergeRequests[j].Warnings = append(projects[i].MergeRequests[j].Warnings, err.Error())
+				continue
+			}
+			projects[i].MergeRequests[j].Pipeline.FailedJobs = lo.Map(
+				jobsByPipeline[mr.Pipeline.ID], func(item gitlab.Job, _ int) Job {
+					return Job{
+						ID:            item.ID,
+						Name:          item.Name,
+						Stage:         item.Stage,
+						Status:        item.Status,
+						WebURL:        item.WebURL,
+						Duration:      time.Duration(item.Duration * float64(time.Second)),
+						AllowFailure:  item.AllowFailure,
+						FailureReason: item.FailureReason,
 					}
 				},
 			)
 		}
 	}
 
-	return projects, nil
+	return projects
 }
 
-func (s *Service) enrichProjectInfoGQ(ctx context.Context, projects []Project) ([]Project, error) {
-	allProjectIDs := lo.Map(projects, func(item Project, _ int) int64 {
-		return item.ID
-	})
+// enrichMRApprovals requests approvals with REST API for merge requests which did not get approval requirements
+// via GraphQL or which approval time is not known from system notes
+func (s *Service) enrichMRApprovals(ctx context.Context, projects []Project) []Project {
+	projects = fillApprovalTimes(projects)
 
 	var mx sync.Mutex
+	approvalsByMR := make(map[int64]map[int64]gitlab.Approval, len(projects))
+	mrErrs := make(map[mergeRequestKey]error)
+
 	group := s.pool.NewGroup()
-	for _, projectID := range allProjectIDs {
-		group.SubmitErr(
-			func() error {
-				if found := func() bool {
+	for _, project := range projects {
+		for _, mr := range project.MergeRequests {
+			if mr.approvalsLoaded && !lo.SomeBy(mr.Approvals, func(item Approval) bool {
+				return item.ApprovedAt.IsZero()
+			}) {
+				continue
+			}
+			group.Submit(
+				func() {
+					approval, err := s.gitlabSvc.GetMergeRequestApprovals(ctx, project.ID, mr.IID)
 					mx.Lock()
 					defer mx.Unlock()
-					_, found := s.projectsByID[projectID]
-					return found
-				}(); found {
-					return nil
-				}
+					if err != nil {
+						mrErrs[mergeRequestKey{projectID: project.ID, iid: mr.IID}] = fmt.Errorf("get approvals: %w", err)
+						return
+					}
+					approvalsByMR[project.ID] = lo.Assign(
+						approvalsByMR[project.ID], map[int64]gitlab.Approval{mr.IID: approval},
+					)
+				},
+			)
+		}
+	}
 
-				project, err := s.gitlabSvc.GetProject(ctx, projectID)
-				if err != nil {
-					return err
+	_ = group.Wait()
+
+	for i, p := range projects {
+		for j, mr := range p.MergeRequests {
+			if err, found := mrErrs[mergeRequestKey{projectID: p.ID, iid: mr.IID}]; found {
+				projects[i].MergeRequests[j].Warnings = append(projects[i].MergeRequests[j].Warnings, err.Error())
+				continue
+			}
+			approval, found := approvalsByMR[p.ID][mr.IID]
+			if !found {
+				continue
+			}
+			if !mr.approvalsLoaded {
+				projects[i].MergeRequests[j].ApprovalRequirements.Required = approval.ApprovalsRequired
+				projects[i].MergeRequests[j].ApprovalRequirements.Left = approval.ApprovalsLeft
+				projects[i].MergeRequests[j].ApprovalRequirements.ApprovableByMe = approval.UserCanApprove
+				projects[i].MergeRequests[j].approvalsLoaded = true
+			}
+			for k, a := range mr.Approvals {
+				if !a.ApprovedAt.IsZero() {
+					continue
 				}
-				mx.Lock()
-				defer mx.Unlock()
-				s.projectsByID[projectID] = project
-				return nil
-			},
-		)
+				if approvedBy, found := lo.Find(approval.ApprovedBy, func(item gitlab.ApprovedBy) bool {
+					return item.User.Username == a.User.Username
+				}); found {
+					projects[i].MergeRequests[j].Approvals[k].ApprovedAt = approvedBy.ApprovedAt
+				}
+			}
+		}
 	}
-	if err := group.Wait(); err != nil {
-		return nil, fmt.Errorf("collect projects: %w", err)
+
+	return projects
+}
+
+// enrichProjectInfoGQ fills projects with their merge requests, projects which could not be fetched
+// have Error set and do not prevent others from being processed
+func (s *Service) enrichProjectInfoGQ(ctx context.Context, projects []Project) []Project {
+	s.forgetProjectPaths(projects)
+
+	allProjectIDs := lo.Uniq(lo.FilterMap(projects, func(item Project, _ int) (int64, bool) {
+		return item.ID, item.Error == nil
+	}))
+
+	projectErrs := s.resolveProjectPaths(ctx, allProjectIDs)
+
+	projectPaths := lo.FilterMap(allProjectIDs, func(item int64, _ int) (string, bool) {
+		path, found := s.projectPathsByID[item]
+		return path, found
+	})
+
+	projectsGQ, pathErrs := s.gitlabSvc.GetProjectsMergeRequestsGQ(ctx, projectPaths)
+	projectsByID := make(map[int64]gitlab.ProjectGQ, len(projectsGQ))
+	for _, projectID := range allProjectIDs {
+		path, found := s.projectPathsByID[projectID]
+		if !found {
+			continue
+		}
+		if err, found := pathErrs[path]; found {
+			projectErrs[projectID] = err
+			if errors.Is(err, gitlab.ErrNotFound) {
+				// project was renamed or moved, its path is resolved again on the next refresh
+				delete(s.projectPathsByID, projectID)
+			}
+			continue
+		}
+		if p, found := projectsGQ[path]; found {
+			projectsByID[projectID] = p
+		}
 	}
 
+	var mx sync.Mutex
 	mrByProject := make(map[int64][]gitlab.MergeRequestGQ, len(projects))
 	rulesByProject := make(map[int64][]gitlab.ApprovalRule, len(projects))
-	group = s.pool.NewGroup()
-	for _, p := range s.projectsByID {
-		group.SubmitErr(
-			func() error {
-				mrs, err := s.gitlabSvc.GetProjectMergeRequestsGQ(ctx, p.PathWithNamespace)
-				if err != nil {
-					return err
-				}
+	group := s.pool.NewGroup()
+	for projectID, projectGQ := range projectsByID {
+		mrByProject[projectID] = projectGQ.MergeRequests
+		rulesByProject[projectID] = approvalRulesFromMergeRequests(projectGQ.MergeRequests)
+
+		if !lo.SomeBy(projectGQ.MergeRequests, func(item gitlab.MergeRequestGQ) bool {
+			return item.ApprovalState == nil
+		}) {
+			continue
+		}
+
+		// approval state is not available via GraphQL, fallback to REST API
+		group.Submit(
+			func() {
+				rules, err := s.gitlabSvc.GetApprovalRules(ctx, projectID)
 				mx.Lock()
 				defer mx.Unlock()
-				mrByProject[p.ID] = mrs
-				return nil
-
-			},
-			func() error {
-				rules, err := s.gitlabSvc.GetApprovalRules(ctx, p.ID)
 				if err != nil {
-					return err
+					projectErrs[projectID] = err
+					return
 				}
-				mx.Lock()
-				defer mx.Unlock()
-				rulesByProject[p.ID] = rules
-				return nil
+				rulesByProject[projectID] = rules
 			},
 		)
 	}
-
-	if err := group.Wait(); err != nil {
-		return nil, fmt.Errorf("collect projects MRs: %w", err)
-	}
+	_ = group.Wait()
 
 	for i, p := range projects {
-		p.WebURL = s.projectsByID[p.ID].WebURL
+		if p.Error != nil {
+			continue
+		}
+		if err, found := projectErrs[p.ID]; found {
+			projects[i].Error = newProjectError(err)
+			continue
+		}
+
+		p.WebURL = projectsByID[p.ID].WebURL
+		p.PathWithNamespace = projectsByID[p.ID].FullPath
+		projects[i].WebURL = p.WebURL
+		projects[i].PathWithNamespace = p.PathWithNamespace
 
 		projects[i].MergeRequests = lo.Map(mrByProject[p.ID], func(mr gitlab.MergeRequestGQ, _ int) MergeRequest {
-			return MergeRequest{
-				IID:         mr.IID,
-				Project:     p,
-				CreatedAt:   mr.CreatedAt,
-				Description: mr.Title,
-				URL:         mr.WebURL,
-				Author: User{
-					Username:  mr.Author.Username,
-					AvatarURL: s.fixURL(mr.Author.AvatarURL),
-					WebURL:    mr.Author.WebURL,
-					IsMe:      s.currentUser.Username == mr.Author.Username,
-				},
-				Approvals: lo.Map(mr.ApprovedBy.Nodes, func(item gitlab.UserGQ, _ int) Approval {
-					return Approval{
-						User: User{
-							Username:  item.Username,
-							AvatarURL: s.fixURL(item.AvatarURL),
-							WebURL:    item.WebURL,
-							IsMe:      s.currentUser.Username == item.Username,
-						},
-					}
-				}),
-				Pipeline: Pipeline{
-					Status: strings.ToLower(mr.HeadPipeline.Status),
+			return s.mergeRequestFromGQ(p, mr)
+		})
+
+		projects[i].ApprovalRules = lo.Map(rulesByProject[p.ID], func(r gitlab.ApprovalRule, _ int) ApprovalRule {
+			return s.approvalRuleFromGitlab(r)
+		})
+	}
+
+	return projects
+}
+
+// resolveProjectPaths finds full paths of projects which are not known yet, paths are kept across refreshes
+// since they are needed to query merge requests only; returns errors of projects which could not be resolved
+func (s *Service) resolveProjectPaths(ctx context.Context, projectIDs []int64) map[int64]error {
+	res := make(map[int64]error)
+
+	unknown := lo.Filter(projectIDs, func(item int64, _ int) bool {
+		_, found := s.projectPathsByID[item]
+		return !found
+	})
+	if len(unknown) == 0 {
+		return res
+	}
+
+	paths, err := s.gitlabSvc.GetProjectPathsGQ(ctx, unknown)
+	for _, projectID := range unknown {
+		if err != nil {
+			res[projectID] = err
+			continue
+		}
+		path, found := paths[projectID]
+		if !found {
+			res[projectID] = fmt.Errorf("project %d: %w", projectID, gitlab.ErrNotFound)
+			continue
+		}
+		s.projectPathsByID[projectID] = path
+	}
+	return res
+}
+
+// forgetProjectPaths drops paths of projects which are not configured anymore, projects which failed to fetch
+// are kept, so their paths are not resolved again
+func (s *Service) forgetProjectPaths(projects []Project) {
+	configured := lo.SliceToMap(projects, func(item Project) (int64, struct{}) {
+		return item.ID, struct{}{}
+	})
+	for projectID := range s.projectPathsByID {
+		if _, found := configured[projectID]; !found {
+			delete(s.projectPathsByID, projectID)
+		}
+	}
+}
+
+func (s *Service) mergeRequestFromGQ(p Project, mr gitlab.MergeRequestGQ) MergeRequest {
+	return MergeRequest{
+		IID:          mr.IID,
+		Project:      p,
+		CreatedAt:    mr.CreatedAt,
+		UpdatedAt:    mr.UpdatedAt,
+		Description:  mr.Title,
+		Body:         mr.Description,
+		Draft:        mr.Draft,
+		URL:          mr.WebURL,
+		SourceBranch: mr.SourceBranch,
+		TargetBranch: mr.TargetBranch,
+		HeadSHA:      mr.DiffHeadSHA,
+		Labels: lo.Map(mr.Labels.Nodes, func(item gitlab.LabelGQ, _ int) Label {
+			return Label{
+				Title: item.Title,
+				Color: item.Color,
+			}
+		}),
+		Author: User{
+			Username:  mr.Author.Username,
+			AvatarURL: s.fixURL(mr.Author.AvatarURL),
+			WebURL:    mr.Author.WebURL,
+			IsMe:      s.isMe(mr.Author.Username),
+		},
+		Milestone: s.milestoneFromGQ(mr.Milestone),
+		Reviewers: lo.Map(mr.Reviewers.Nodes, s.userFromGQ),
+		Assignees: lo.Map(mr.Assignees.Nodes, s.userFromGQ),
+		Approvals: lo.Map(mr.ApprovedBy.Nodes, func(item gitlab.UserGQ, _ int) Approval {
+			return Approval{
+				User: User{
+					Username:  item.Username,
+					AvatarURL: s.fixURL(item.AvatarURL),
+					WebURL:    item.WebURL,
+					IsMe:      s.isMe(item.Username),
 				},
-				Status: Status{
-					Conflict: mr.Conflicts,
+			}
+		}),
+		Pipeline: s.pipelineFromGQ(mr.HeadPipeline),
+		Status: Status{
+			Conflict: mr.Conflicts,
+		},
+		DiffStatsSummary: DiffStatsSummary{
+			Additions: mr.DiffStatsSummary.Additions,
+			Deletions: mr.DiffStatsSummary.Deletions,
+			FileCount: mr.DiffStatsSummary.FileCount,
+		},
+		ApprovalRequirements: s.approvalRequirementsFromGQ(mr),
+		Discussions:          s.discussionsFromGQ(mr.Discussions),
+		discussionsLoaded:    mr.Discussions.Complete(),
+		approvalsLoaded:      mr.ApprovalsLeft != nil,
+	}
+}
+
+func (s *Service) pipelineFromGQ(p gitlab.PipelineGQ) Pipeline {
+	id, _ := strconv.ParseInt(idFromGID(p.ID), 10, 64)
+	res := Pipeline{
+		ID:       id,
+		Status:   strings.ToLower(p.Status),
+		Duration: time.Duration(p.Duration) * time.Second,
+	}
+	if len(p.Path) > 0 {
+		res.WebURL = s.fixURL(p.Path)
+	}
+	return res
+}
+
+func (s *Service) milestoneFromGQ(m *gitlab.MilestoneGQ) *Milestone {
+	if m == nil {
+		return nil
+	}
+	res := &Milestone{
+		Title: m.Title,
+	}
+	if len(m.WebPath) > 0 {
+		res.WebURL = s.fixURL(m.WebPath)
+	}
+	if len(m.DueDate) >= len(time.DateOnly) {
+		res.DueDate, _ = time.Parse(time.DateOnly, m.DueDate[:len(time.DateOnly)])
+	}
+	return res
+}
+
+func (s *Service) approvalRequirementsFromGQ(mr gitlab.MergeRequestGQ) ApprovalRequirements {
+	res := ApprovalRequirements{
+		Required:       lo.FromPtr(mr.ApprovalsRequired),
+		Left:           lo.FromPtr(mr.ApprovalsLeft),
+		ApprovableByMe: mr.UserPermissions.CanApprove,
+	}
+	if mr.ApprovalState != nil {
+		res.Rules = lo.Map(mr.ApprovalState.Rules, func(r gitlab.ApprovalRuleGQ, _ int) ApprovalRule {
+			return ApprovalRule{
+				Name:              r.Name,
+				Type:              strings.ToLower(r.Type),
+				Users:             lo.Map(r.EligibleApprovers, s.userFromGQ),
+				ApprovalsRequired: r.ApprovalsRequired,
+				Approved:          r.Approved,
+				ApprovedBy:        lo.Map(r.ApprovedBy.Nodes, s.userFromGQ),
+			}
+		})
+	}
+	return res
+}
+
+func (s *Service) userFromGQ(item gitlab.UserGQ, _ int) User {
+	return User{
+		Username:  item.Username,
+		AvatarURL: s.fixURL(item.AvatarURL),
+		WebURL:    item.WebURL,
+		IsMe:      s.isMe(item.Username),
+	}
+}
+
+func (s *Service) approvalRuleFromGitlab(r gitlab.ApprovalRule) ApprovalRule {
+	return ApprovalRule{
+		Name:              r.Name,
+		Type:              r.RuleType,
+		ApprovalsRequired: r.ApprovalsRequired,
+		Users: lo.Map(r.EligibleApprovers, func(item gitlab.User, _ int) User {
+			return User{
+				Username:  item.Username,
+				AvatarURL: s.fixURL(item.AvatarURL),
+				WebURL:    item.WebURL,
+				IsMe:      s.isMe(item.Username),
+			}
+		}),
+	}
+}
+
+func (s *Service) discussionFromGitlab(d gitlab.Discussion, _ int) Discussion {
+	return Discussion{
+		ID:         d.ID,
+		Resolvable: lo.SomeBy(d.Notes, func(item gitlab.Note) bool { return item.Resolvable }),
+		Resolved:   d.Resolved(),
+		Notes: lo.Map(d.Notes, func(item gitlab.Note, _ int) Note {
+			return Note{
+				ID: item.ID,
+				Author: User{
+					Username:  item.Author.Username,
+					AvatarURL: s.fixURL(item.Author.AvatarURL),
+					WebURL:    item.Author.WebURL,
+					IsMe:      s.isMe(item.Author.Username),
 				},
-				DiffStatsSummary: DiffStatsSummary{
-					Additions: mr.DiffStatsSummary.Additions,
-					Deletions: mr.DiffStatsSummary.Deletions,
-					FileCount: mr.DiffStatsSummary.FileCount,
+				ResolvedBy: User{
+					Username:  item.ResolvedBy.Username,
+					AvatarURL: s.fixURL(item.ResolvedBy.AvatarURL),
+					WebURL:    item.ResolvedBy.WebURL,
+					IsMe:      s.isMe(item.ResolvedBy.Username),
 				},
+				System:     item.System,
+				Resolved:   item.Resolved,
+				Resolvable: item.Resolvable,
+				CreatedAt:  item.CreatedAt,
+				ResolveAt:  item.ResolvedAt,
+				Body:       item.Body,
 			}
-		})
+		}),
+	}
+}
 
-		projects[i].ApprovalRules = lo.Map(rulesByProject[p.ID], func(r gitlab.ApprovalRule, _ int) ApprovalRule {
-			return ApprovalRule{
-				Name: r.Name,
-				Users: lo.Map(r.EligibleApprovers, func(item gitlab.User, _ int) User {
-					return User{
-						Username:  item.Username,
-						AvatarURL: item.AvatarURL,
-						WebURL:    item.WebURL,
-						IsMe:      s.currentUser.Username == item.Username,
+func (s *Service) discussionsFromGQ(discussions *gitlab.DiscussionsGQ) []Discussion {
+	if !discussions.Complete() {
+		return nil
+	}
+	return lo.Map(discussions.Nodes, func(d gitlab.DiscussionGQ, _ int) Discussion {
+		return Discussion{
+			ID:         idFromGID(d.ID),
+			Resolvable: d.Resolvable,
+			Resolved:   d.Resolved,
+			Notes: lo.Map(d.Notes.Nodes, func(item gitlab.NoteGQ, _ int) Note {
+				id, _ := strconv.ParseInt(idFromGID(item.ID), 10, 64)
+				return Note{
+					ID: id,
+					Author: User{
+						Username:  item.Author.Username,
+						AvatarURL: s.fixURL(item.Author.AvatarURL),
+						WebURL:    item.Author.WebURL,
+						IsMe:      s.isMe(item.Author.Username),
+					},
+					ResolvedBy: User{
+						Username:  item.ResolvedBy.Username,
+						AvatarURL: s.fixURL(item.ResolvedBy.AvatarURL),
+						WebURL:    item.ResolvedBy.WebURL,
+						IsMe:      s.isMe(item.ResolvedBy.Username),
+					},
+					System:     item.System,
+					Resolved:   item.Resolved,
+					Resolvable: item.Resolvable,
+					CreatedAt:  item.CreatedAt,
+					ResolveAt:  item.ResolvedAt,
+					Body:       item.Body,
+				}
+			}),
+		}
+	})
+}
+
+// approvalRulesFromMergeRequests collects distinct approval rules from approval state of the project merge requests
+func approvalRulesFromMergeRequests(mrs []gitlab.MergeRequestGQ) []gitlab.ApprovalRule {
+	var res []gitlab.ApprovalRule
+	for _, mr := range mrs {
+		if mr.ApprovalState == nil {
+			continue
+		}
+		for _, r := range mr.ApprovalState.Rules {
+			if lo.ContainsBy(res, func(item gitlab.ApprovalRule) bool {
+				return item.Name == r.Name
+			}) {
+				continue
+			}
+			res = append(res, gitlab.ApprovalRule{
+				Name:              r.Name,
+				RuleType:          strings.ToLower(r.Type),
+				ApprovalsRequired: r.ApprovalsRequired,
+				EligibleApprovers: lo.Map(r.EligibleApprovers, func(item gitlab.UserGQ, _ int) gitlab.User {
+					return gitlab.User{
+						Username:    item.Username,
+						AvatarURL:   item.AvatarURL,
+						WebURL:      item.WebURL,
+						Name:        item.Name,
+						PublicEmail: item.PublicEmail,
 					}
 				}),
-			}
-		})
+			})
+		}
 	}
+	return res
+}
+
+func newProjectError(err error) *ProjectError {
+	return &ProjectError{
+		StatusCode: gitlab.ErrorStatusCode(err),
+		Message:    err.Error(),
+	}
+}
 
-	return projects, nil
+// idFromGID returns ID used by REST API from GraphQL global ID, i.e. gid://gitlab/Note/123
+func idFromGID(gid string) string {
+	return gid[strings.LastIndex(gid, "/")+1:]
 }
 
 func (s *Service) fixURL(url string) string {
@@ -0,0 +1,60 @@
+package mr
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/vlanse/glmr/internal/service/gitlab"
+)
+
+func TestApprovalRuleFromGitlab(t *testing.T) {
+	svc := NewService(gitlab.NewService(gitlab.Settings{URL: "https://gitlab.example.com"}), nil)
+	svc.currentUser = &User{Username: "bob"}
+
+	tests := []struct {
+		name     string
+		approver gitlab.User
+		wantUser User
+	}{
+		{
+			name:     "relative avatar URL",
+			approver: gitlab.User{Username: "alice", AvatarURL: "/uploads/user/avatar/1/a.png"},
+			wantUser: User{Username: "alice", AvatarURL: "https://gitlab.example.com/uploads/user/avatar/1/a.png"},
+		},
+		{
+			name:     "absolute avatar URL",
+			approver: gitlab.User{Username: "alice", AvatarURL: "https://secure.gravatar.com/avatar/a"},
+			wantUser: User{Username: "alice", AvatarURL: "https://secure.gravatar.com/avatar/a"},
+		},
+		{
+			name:     "current user",
+			approver: gitlab.User{Username: "bob"},
+			wantUser: User{Username: "bob", IsMe: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := svc.approvalRuleFromGitlab(gitlab.ApprovalRule{Name: "backend", EligibleApprovers: []gitlab.User{tt.approver}})
+			if len(got.Users) != 1 || got.Users[0] != tt.wantUser {
+				t.Errorf("approvalRuleFromGitlab() users = %+v, want %+v", got.Users, tt.wantUser)
+			}
+		})
+	}
+}
+
+func TestForgetProjectPaths(t *testing.T) {
+	svc := NewService(nil, nil)
+	svc.projectPathsByID = map[int64]string{1: "g/configured", 2: "g/failed", 3: "g/removed"}
+
+	svc.forgetProjectPaths([]Project{
+		{ID: 1},
+		{ID: 2, Error: &ProjectError{Message: "forbidden"}},
+		{GroupName: "g", Name: "namespace", Error: &ProjectError{Message: "forbidden"}},
+	})
+
+	want := map[int64]string{1: "g/configured", 2: "g/failed"}
+	if !maps.Equal(svc.projectPathsByID, want) {
+		t.Errorf("project paths = %v, want %v", svc.projectPathsByID, want)
+	}
+}
@@ -0,0 +1,244 @@
+package mr
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/vlanse/glmr/internal/service/gitlab"
+)
+
+type EventType string
+
+const (
+	EventOpened                EventType = "opened"
+	EventClosed                EventType = "closed"
+	EventMerged                EventType = "merged"
+	EventApproved              EventType = "approved"
+	EventPipelineStatusChanged EventType = "pipeline_status_changed"
+	EventUnresolvedThreadAdded EventType = "unresolved_thread_added"
+	EventConflictAppeared      EventType = "conflict_appeared"
+
+	mergedState = "merged"
+	openedState = "opened"
+
+	subscriberBufferSize = 100
+
+	// stateLookupTimeout limits lookups of states of merge requests which disappeared from snapshot
+	stateLookupTimeout = 30 * time.Second
+)
+
+type Event struct {
+	Type         EventType
+	OccurredAt   time.Time
+	MergeRequest MergeRequest
+	// User is set for approval events
+	User User
+	// PreviousPipelineStatus is set for pipeline status change events, new status is in MergeRequest
+	PreviousPipelineStatus string
+	// MergedAt is set for merge events when gitlab reports it
+	MergedAt time.Time
+}
+
+type mergeRequestKey struct {
+	projectID int64
+	iid       int64
+}
+
+type subscribers struct {
+	mx   sync.Mutex
+	next int
+	chs  map[int]chan Event
+}
+
+// Subscribe returns channel with merge request events detected on every snapshot refresh;
+// events are dropped when subscriber does not keep up, call returned func to unsubscribe
+func (s *Service) Subscribe() (<-chan Event, func()) {
+	s.subs.mx.Lock()
+	defer s.subs.mx.Unlock()
+
+	if s.subs.chs == nil {
+		s.subs.chs = make(map[int]chan Event)
+	}
+
+	id := s.subs.next
+	s.subs.next++
+	ch := make(chan Event, subscriberBufferSize)
+	s.subs.chs[id] = ch
+
+	return ch, func() {
+		s.subs.mx.Lock()
+		defer s.subs.mx.Unlock()
+		if ch, found := s.subs.chs[id]; found {
+			delete(s.subs.chs, id)
+			close(ch)
+		}
+	}
+}
+
+func (s *Service) publish(events []Event) {
+	s.subs.mx.Lock()
+	defer s.subs.mx.Unlock()
+
+	for _, e := range events {
+		for _, ch := range s.subs.chs {
+			select {
+			case ch <- e:
+			default:
+			}
+		}
+	}
+}
+
+// diffSnapshots detects changes of merge requests between two consecutive snapshots which happened at now,
+// projects which were not fetched successfully in both snapshots are skipped, i.e. after configuration change
+func (s *Service) diffSnapshots(ctx context.Context, prev, cur *snapshot, now time.Time) []Event {
+	if prev == nil {
+		return nil
+	}
+
+	prevMRs := mergeRequestsByKey(prev.projects)
+	curMRs := mergeRequestsByKey(cur.projects)
+	prevProjects := fetchedProjectIDs(prev.projects)
+	curProjects := fetchedProjectIDs(cur.projects)
+
+	var events []Event
+	for key, mr := range curMRs {
+		if !prevProjects[key.projectID] || !curProjects[key.projectID] {
+			continue
+		}
+
+		prevMR, found := prevMRs[key]
+		if !found {
+			events = append(events, Event{Type: EventOpened, OccurredAt: now, MergeRequest: mr})
+			continue
+		}
+
+		events = append(events, diffMergeRequests(now, prevMR, mr)...)
+	}
+
+	var disappeared []mergeRequestKey
+	for key := range prevMRs {
+		if !prevProjects[key.projectID] || !curProjects[key.projectID] {
+			continue
+		}
+		if _, found := curMRs[key]; !found {
+			disappeared = append(disappeared, key)
+		}
+	}
+
+	for key, info := range s.lookupStates(ctx, disappeared) {
+		var eventType EventType
+		switch info.State {
+		case mergedState:
+			eventType = EventMerged
+		case closedState:
+			eventType = EventClosed
+		default:
+			// merge request is not related to the current user anymore and left dynamic group
+			continue
+		}
+		events = append(events, Event{
+			Type:         eventType,
+			OccurredAt:   now,
+			MergeRequest: prevMRs[key],
+			MergedAt:     lo.FromPtr(info.MergedAt),
+		})
+	}
+
+	return events
+}
+
+// lookupStates fetches current states of merge requests concurrently, merge requests whose state could not be
+// fetched, i.e. because ctx is done, are missing in result; deleted ones are considered closed
+func (s *Service) lookupStates(ctx context.Context, keys []mergeRequestKey) map[mergeRequestKey]gitlab.MergeRequestInfo {
+	ctx, cancel := context.WithTimeout(ctx, stateLookupTimeout)
+	defer cancel()
+
+	var mx sync.Mutex
+	res := make(map[mergeRequestKey]gitlab.MergeRequestInfo, len(keys))
+
+	group := s.pool.NewGroup()
+	for _, key := range keys {
+		group.Submit(func() {
+			info, err := s.gitlabSvc.GetMergeRequestInfo(ctx, key.projectID, key.iid)
+			if gitlab.ErrorStatusCode(err) == http.StatusNotFound {
+				// merge request or its project was deleted
+				info, err = gitlab.MergeRequestInfo{State: closedState}, nil
+			}
+			if err != nil {
+				log.Printf("could not get state of merge request %d!%d: %v", key.projectID, key.iid, err)
+				return
+			}
+			mx.Lock()
+			defer mx.Unlock()
+			res[key] = info
+		})
+	}
+	_ = group.Wait()
+
+	return res
+}
+
+func diffMergeRequests(now time.Time, prev, cur MergeRequest) []Event {
+	var events []Event
+
+	for _, a := range cur.Approvals {
+		if !lo.ContainsBy(prev.Approvals, func(item Approval) bool {
+			return item.User.Username == a.User.Username
+		}) {
+			events = append(events, Event{Type: EventApproved, OccurredAt: now, MergeRequest: cur, User: a.User})
+		}
+	}
+
+	if prev.Pipeline.Status != cur.Pipeline.Status {
+		events = append(events, Event{
+			Type:                   EventPipelineStatusChanged,
+			OccurredAt:             now,
+			MergeRequest:           cur,
+			PreviousPipelineStatus: prev.Pipeline.Status,
+		})
+	}
+
+	// thread counts mean nothing when discussions could not be fetched
+	if prev.discussionsLoaded && cur.discussionsLoaded && countUnresolvedThreads(cur) > countUnresolvedThreads(prev) {
+		events = append(events, Event{Type: EventUnresolvedThreadAdded, OccurredAt: now, MergeRequest: cur})
+	}
+
+	if cur.Status.Conflict && !prev.Status.Conflict {
+		events = append(events, Event{Type: EventConflictAppeared, OccurredAt: now, MergeRequest: cur})
+	}
+
+	return events
+}
+
+func countUnresolvedThreads(mr MergeRequest) int {
+	return lo.CountBy(mr.Discussions, func(d Discussion) bool {
+		return lo.SomeBy(d.Notes, func(n Note) bool {
+			return n.Resolvable && !n.Resolved
+		})
+	})
+}
+
+func fetchedProjectIDs(projects []Project) map[int64]bool {
+	res := make(map[int64]bool, len(projects))
+	for _, p := range projects {
+		if p.Error == nil {
+			res[p.ID] = true
+		}
+	}
+	return res
+}
+
+func mergeRequestsByKey(projects []Project) map[mergeRequestKey]MergeRequest {
+	res := make(map[mergeRequestKey]MergeRequest)
+	for _, p := range projects {
+		for _, mr := range p.MergeRequests {
+			res[mergeRequestKey{projectID: p.ID, iid: mr.IID}] = mr
+		}
+	}
+	return res
+}
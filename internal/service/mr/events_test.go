@@ -0,0 +1,237 @@
+package mr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vlanse/glmr/internal/service/gitlab"
+	"github.com/vlanse/glmr/internal/util/request"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	mergedAt := time.Date(2026, time.March, 2, 15, 4, 5, 0, time.UTC)
+	now := mergedAt.Add(time.Minute)
+
+	// states of merge requests which disappear from snapshots, keyed by IID
+	gitlabSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/merge_requests/101"):
+			_, _ = fmt.Fprintf(w, `{"state": "merged", "merged_at": %q}`, mergedAt.Format(time.RFC3339))
+		case strings.HasSuffix(r.URL.Path, "/merge_requests/102"):
+			_, _ = fmt.Fprint(w, `{"state": "closed"}`)
+		case strings.HasSuffix(r.URL.Path, "/merge_requests/103"):
+			_, _ = fmt.Fprint(w, `{"state": "opened"}`)
+		case strings.HasSuffix(r.URL.Path, "/merge_requests/105"):
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer gitlabSrv.Close()
+
+	svc := NewService(gitlab.NewService(gitlab.Settings{
+		URL:  gitlabSrv.URL,
+		HTTP: request.Settings{MaxRetries: -1},
+	}), nil)
+
+	project := func(id int64, mrs ...MergeRequest) Project {
+		return Project{ID: id, MergeRequests: mrs}
+	}
+	failed := func(p Project) Project {
+		p.Error = newProjectError(errors.New("boom"))
+		return p
+	}
+	mr := func(iid int64) MergeRequest {
+		return MergeRequest{IID: iid, Pipeline: Pipeline{Status: "running"}, discussionsLoaded: true}
+	}
+	withApprovals := func(mr MergeRequest, usernames ...string) MergeRequest {
+		for _, u := range usernames {
+			mr.Approvals = append(mr.Approvals, Approval{User: User{Username: u}})
+		}
+		return mr
+	}
+	withPipeline := func(mr MergeRequest, status string) MergeRequest {
+		mr.Pipeline.Status = status
+		return mr
+	}
+	withThreads := func(mr MergeRequest, resolved ...bool) MergeRequest {
+		for _, r := range resolved {
+			mr.Discussions = append(mr.Discussions, Discussion{
+				Notes: []Note{{Resolvable: true, Resolved: r}},
+			})
+		}
+		return mr
+	}
+	withoutDiscussions := func(mr MergeRequest) MergeRequest {
+		mr.discussionsLoaded = false
+		return mr
+	}
+	withConflict := func(mr MergeRequest) MergeRequest {
+		mr.Status.Conflict = true
+		return mr
+	}
+
+	type event struct {
+		Type                   EventType
+		IID                    int64
+		User                   string
+		PreviousPipelineStatus string
+		MergedAt               time.Time
+	}
+
+	tests := []struct {
+		name string
+		prev []Project
+		cur  []Project
+		want []event
+	}{
+		{
+			name: "no previous snapshot",
+			prev: nil,
+			cur:  []Project{project(1, mr(1))},
+			want: nil,
+		},
+		{
+			name: "nothing changed",
+			prev: []Project{project(1, withApprovals(mr(1), "bob"))},
+			cur:  []Project{project(1, withApprovals(mr(1), "bob"))},
+			want: nil,
+		},
+		{
+			name: "merge request opened",
+			prev: []Project{project(1, mr(1))},
+			cur:  []Project{project(1, mr(1), mr(2))},
+			want: []event{{Type: EventOpened, IID: 2}},
+		},
+		{
+			name: "new approvals",
+			prev: []Project{project(1, withApprovals(mr(1), "bob"))},
+			cur:  []Project{project(1, withApprovals(mr(1), "bob", "carol", "dave"))},
+			want: []event{
+				{Type: EventApproved, IID: 1, User: "carol"},
+				{Type: EventApproved, IID: 1, User: "dave"},
+			},
+		},
+		{
+			name: "revoked approval",
+			prev: []Project{project(1, withApprovals(mr(1), "bob"))},
+			cur:  []Project{project(1, mr(1))},
+			want: nil,
+		},
+		{
+			name: "pipeline status changed",
+			prev: []Project{project(1, withPipeline(mr(1), "running"))},
+			cur:  []Project{project(1, withPipeline(mr(1), "failed"))},
+			want: []event{{Type: EventPipelineStatusChanged, IID: 1, PreviousPipelineStatus: "running"}},
+		},
+		{
+			name: "unresolved thread added",
+			prev: []Project{project(1, withThreads(mr(1), false, true))},
+			cur:  []Project{project(1, withThreads(mr(1), false, true, false))},
+			want: []event{{Type: EventUnresolvedThreadAdded, IID: 1}},
+		},
+		{
+			name: "discussions of previous state are unknown",
+			prev: []Project{project(1, withoutDiscussions(mr(1)))},
+			cur:  []Project{project(1, withThreads(mr(1), false))},
+			want: nil,
+		},
+		{
+			name: "discussions of current state are unknown",
+			prev: []Project{project(1, withThreads(mr(1), false))},
+			cur:  []Project{project(1, withoutDiscussions(withThreads(mr(1), false, false)))},
+			want: nil,
+		},
+		{
+			name: "thread resolved and another one added",
+			prev: []Project{project(1, withThreads(mr(1), false))},
+			cur:  []Project{project(1, withThreads(mr(1), true, false))},
+			want: nil,
+		},
+		{
+			name: "conflict appeared",
+			prev: []Project{project(1, mr(1))},
+			cur:  []Project{project(1, withConflict(mr(1)))},
+			want: []event{{Type: EventConflictAppeared, IID: 1}},
+		},
+		{
+			name: "conflict persists",
+			prev: []Project{project(1, withConflict(mr(1)))},
+			cur:  []Project{project(1, withConflict(mr(1)))},
+			want: nil,
+		},
+		{
+			name: "disappeared merge requests are looked up",
+			prev: []Project{project(1, mr(101), mr(102), mr(103), mr(104), mr(105))},
+			cur:  []Project{project(1)},
+			want: []event{
+				{Type: EventMerged, IID: 101, MergedAt: mergedAt},
+				{Type: EventClosed, IID: 102},
+				// 103 is still opened, i.e. it left dynamic group; 104 is deleted; state of 105 is unknown
+				{Type: EventClosed, IID: 104},
+			},
+		},
+		{
+			name: "project failed in current snapshot",
+			prev: []Project{project(1, mr(101), mr(102))},
+			cur:  []Project{failed(project(1))},
+			want: nil,
+		},
+		{
+			name: "project failed in previous snapshot",
+			prev: []Project{failed(project(1))},
+			cur:  []Project{project(1, mr(1), mr(2))},
+			want: nil,
+		},
+		{
+			name: "other projects are not affected by failed one",
+			prev: []Project{failed(project(1)), project(2, mr(1))},
+			cur:  []Project{project(1, mr(1)), project(2, mr(1), mr(2))},
+			want: []event{{Type: EventOpened, IID: 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var prev *snapshot
+			if tt.prev != nil {
+				prev = &snapshot{projects: tt.prev}
+			}
+			events := svc.diffSnapshots(context.Background(), prev, &snapshot{projects: tt.cur}, now)
+
+			var got []event
+			for _, e := range events {
+				if !e.OccurredAt.Equal(now) {
+					t.Errorf("event %s of %d occurred at %v, want %v", e.Type, e.MergeRequest.IID, e.OccurredAt, now)
+				}
+				got = append(got, event{
+					Type:                   e.Type,
+					IID:                    e.MergeRequest.IID,
+					User:                   e.User.Username,
+					PreviousPipelineStatus: e.PreviousPipelineStatus,
+					MergedAt:               e.MergedAt,
+				})
+			}
+			slices.SortFunc(got, func(a, b event) int {
+				if a.IID != b.IID {
+					return int(a.IID - b.IID)
+				}
+				return strings.Compare(string(a.Type)+a.User, string(b.Type)+b.User)
+			})
+
+			if !slices.EqualFunc(got, tt.want, func(a, b event) bool {
+				return a.Type == b.Type && a.IID == b.IID && a.User == b.User &&
+					a.PreviousPipelineStatus == b.PreviousPipelineStatus && a.MergedAt.Equal(b.MergedAt)
+			}) {
+				t.Errorf("diffSnapshots() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package mr
+
+import (
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+// noPipelineStatus is used in Filter.PipelineStatuses to match merge requests without pipeline
+const noPipelineStatus = "none"
+
+type predicate func(mr MergeRequest) bool
+
+func filterMergeRequests(groups []MergeRequestsGroup, currentUsername string, filter Filter, now time.Time) []MergeRequestsGroup {
+	predicates := filter.predicates(currentUsername, now)
+	for i, group := range groups {
+		groups[i].MergeRequests = lo.Filter(group.MergeRequests, func(item MergeRequest, _ int) bool {
+			return lo.EveryBy(predicates, func(p predicate) bool {
+				return p(item)
+			})
+		})
+	}
+	return groups
+}
+
+func (f Filter) predicates(currentUsername string, now time.Time) []predicate {
+	var res []predicate
+
+	isMine := func(mr MergeRequest) bool {
+		return mr.Author.Username == currentUsername
+	}
+	// unlessStillShowMine makes mine MRs pass the predicate when "but still show mine" is enabled
+	unlessStillShowMine := func(p predicate) predicate {
+		return func(mr MergeRequest) bool {
+			return (f.ButStillShowMine && isMine(mr)) || p(mr)
+		}
+	}
+
+	if f.DoNotShowDrafts {
+		res = append(res, unlessStillShowMine(func(mr MergeRequest) bool {
+			return !mr.Draft
+		}))
+	}
+	if f.SkipApprovedByMe {
+		res = append(res, unlessStillShowMine(func(mr MergeRequest) bool {
+			return !lo.ContainsBy(mr.Approvals, func(item Approval) bool {
+				return item.User.Username == currentUsername
+			})
+		}))
+	}
+	if f.ShowOnlyMine {
+		res = append(res, isMine)
+	}
+
+	if len(f.Authors) > 0 {
+		res = append(res, func(mr MergeRequest) bool {
+			return lo.Contains(f.Authors, mr.Author.Username)
+		})
+	}
+	if len(f.Reviewers) > 0 {
+		res = append(res, func(mr MergeRequest) bool {
+			return lo.ContainsBy(mr.Reviewers, func(item User) bool {
+				return lo.Contains(f.Reviewers, item.Username)
+			})
+		})
+	}
+	if len(f.Labels) > 0 {
+		res = append(res, func(mr MergeRequest) bool {
+			return lo.ContainsBy(mr.Labels, func(item Label) bool {
+				return lo.Contains(f.Labels, item.Title)
+			})
+		})
+	}
+	if len(f.TargetBranches) > 0 {
+		res = append(res, func(mr MergeRequest) bool {
+			return lo.Contains(f.TargetBranches, mr.TargetBranch)
+		})
+	}
+	if len(f.PipelineStatuses) > 0 {
+		res = append(res, func(mr MergeRequest) bool {
+			status := mr.Pipeline.Status
+			if mr.Pipeline.ID == 0 {
+				status = noPipelineStatus
+			}
+			return lo.ContainsBy(f.PipelineStatuses, func(item string) bool {
+				return strings.EqualFold(item, status)
+			})
+		})
+	}
+	if f.Conflict != nil {
+		res = append(res, func(mr MergeRequest) bool {
+			return mr.Status.Conflict == *f.Conflict
+		})
+	}
+	if f.HasUnresolved != nil {
+		res = append(res, func(mr MergeRequest) bool {
+			return (mr.CommentStats.UnresolvedCount > 0) == *f.HasUnresolved
+		})
+	}
+	if f.MinAge > 0 {
+		res = append(res, func(mr MergeRequest) bool {
+			return now.Sub(mr.CreatedAt) >= f.MinAge
+		})
+	}
+	if f.MaxAge > 0 {
+		res = append(res, func(mr MergeRequest) bool {
+			return now.Sub(mr.CreatedAt) <= f.MaxAge
+		})
+	}
+	if f.MinDiffSize > 0 {
+		res = append(res, func(mr MergeRequest) bool {
+			return diffSize(mr) >= f.MinDiffSize
+		})
+	}
+	if f.MaxDiffSize > 0 {
+		res = append(res, func(mr MergeRequest) bool {
+			return diffSize(mr) <= f.MaxDiffSize
+		})
+	}
+	if text := strings.ToLower(strings.TrimSpace(f.Text)); len(text) > 0 {
+		res = append(res, func(mr MergeRequest) bool {
+			return strings.Contains(strings.ToLower(mr.Description), text) ||
+				strings.Contains(strings.ToLower(mr.Body), text)
+		})
+	}
+	if len(f.Tags) > 0 {
+		res = append(res, func(mr MergeRequest) bool {
+			return lo.ContainsBy(mr.Tags, func(item string) bool {
+				return lo.Contains(f.Tags, item)
+			})
+		})
+	}
+	if f.Seen != nil {
+		res = append(res, func(mr MergeRequest) bool {
+			return mr.Marks.Seen == *f.Seen
+		})
+	}
+	if f.Pinned != nil {
+		res = append(res, func(mr MergeRequest) bool {
+			return mr.Marks.Pinned == *f.Pinned
+		})
+	}
+	if !f.ShowSnoozed {
+		res = append(res, func(mr MergeRequest) bool {
+			return !mr.Marks.Snoozed
+		})
+	}
+
+	return res
+}
+
+func diffSize(mr MergeRequest) int64 {
+	return mr.DiffStatsSummary.Additions + mr.DiffStatsSummary.Deletions
+}
@@ -0,0 +1,200 @@
+package mr
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+func TestFilterPredicates(t *testing.T) {
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	const me = "me"
+
+	const (
+		mineDraft = iota + 1
+		approvedByMe
+		plain
+		snoozed
+	)
+	mrs := []MergeRequest{
+		{
+			IID:              mineDraft,
+			Description:      "Draft: add metrics",
+			Author:           User{Username: me},
+			Draft:            true,
+			Labels:           []Label{{Title: "backend"}},
+			TargetBranch:     "main",
+			Pipeline:         Pipeline{ID: 1, Status: "success"},
+			CreatedAt:        now.Add(-time.Hour),
+			DiffStatsSummary: DiffStatsSummary{Additions: 8, Deletions: 2},
+			Tags:             []string{"later"},
+		},
+		{
+			IID:              approvedByMe,
+			Description:      "Rework settings page",
+			Author:           User{Username: "bob"},
+			Reviewers:        []User{{Username: me}},
+			Approvals:        []Approval{{User: User{Username: me}}},
+			Labels:           []Label{{Title: "frontend"}},
+			TargetBranch:     "release",
+			Pipeline:         Pipeline{ID: 2, Status: "failed"},
+			Status:           Status{Conflict: true},
+			CommentStats:     CommentStats{UnresolvedCount: 2},
+			CreatedAt:        now.Add(-72 * time.Hour),
+			DiffStatsSummary: DiffStatsSummary{Additions: 500, Deletions: 100},
+		},
+		{
+			IID:              plain,
+			Description:      "Fix Login bug",
+			Author:           User{Username: "carol"},
+			TargetBranch:     "main",
+			CreatedAt:        now.Add(-24 * time.Hour),
+			DiffStatsSummary: DiffStatsSummary{Additions: 60, Deletions: 40},
+			Marks:            Marks{Seen: true, Pinned: true},
+		},
+		{
+			IID:          snoozed,
+			Description:  "Bump dependencies",
+			Body:         "Fixes flaky test",
+			Author:       User{Username: "dave"},
+			TargetBranch: "main",
+			Pipeline:     Pipeline{ID: 3, Status: "running"},
+			CreatedAt:    now.Add(-24 * time.Hour),
+			Marks:        Marks{Snoozed: true},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   []int64
+	}{
+		{
+			name:   "snoozed are hidden by default",
+			filter: Filter{},
+			want:   []int64{mineDraft, approvedByMe, plain},
+		},
+		{
+			name:   "show snoozed",
+			filter: Filter{ShowSnoozed: true},
+			want:   []int64{mineDraft, approvedByMe, plain, snoozed},
+		},
+		{
+			name:   "no drafts",
+			filter: Filter{ShowSnoozed: true, DoNotShowDrafts: true},
+			want:   []int64{approvedByMe, plain, snoozed},
+		},
+		{
+			name:   "no drafts but still show mine",
+			filter: Filter{ShowSnoozed: true, DoNotShowDrafts: true, ButStillShowMine: true},
+			want:   []int64{mineDraft, approvedByMe, plain, snoozed},
+		},
+		{
+			name:   "skip approved by me",
+			filter: Filter{ShowSnoozed: true, SkipApprovedByMe: true},
+			want:   []int64{mineDraft, plain, snoozed},
+		},
+		{
+			name:   "only mine",
+			filter: Filter{ShowSnoozed: true, ShowOnlyMine: true},
+			want:   []int64{mineDraft},
+		},
+		{
+			name:   "authors",
+			filter: Filter{ShowSnoozed: true, Authors: []string{"bob", "carol"}},
+			want:   []int64{approvedByMe, plain},
+		},
+		{
+			name:   "reviewers",
+			filter: Filter{ShowSnoozed: true, Reviewers: []string{me}},
+			want:   []int64{approvedByMe},
+		},
+		{
+			name:   "labels",
+			filter: Filter{ShowSnoozed: true, Labels: []string{"backend", "ops"}},
+			want:   []int64{mineDraft},
+		},
+		{
+			name:   "target branches",
+			filter: Filter{ShowSnoozed: true, TargetBranches: []string{"release"}},
+			want:   []int64{approvedByMe},
+		},
+		{
+			name:   "pipeline statuses are case-insensitive and match missing pipeline",
+			filter: Filter{ShowSnoozed: true, PipelineStatuses: []string{"FAILED", noPipelineStatus}},
+			want:   []int64{approvedByMe, plain},
+		},
+		{
+			name:   "without conflict",
+			filter: Filter{ShowSnoozed: true, Conflict: lo.ToPtr(false)},
+			want:   []int64{mineDraft, plain, snoozed},
+		},
+		{
+			name:   "has unresolved threads",
+			filter: Filter{ShowSnoozed: true, HasUnresolved: lo.ToPtr(true)},
+			want:   []int64{approvedByMe},
+		},
+		{
+			name:   "min age is inclusive",
+			filter: Filter{ShowSnoozed: true, MinAge: 24 * time.Hour},
+			want:   []int64{approvedByMe, plain, snoozed},
+		},
+		{
+			name:   "max age is inclusive",
+			filter: Filter{ShowSnoozed: true, MaxAge: 24 * time.Hour},
+			want:   []int64{mineDraft, plain, snoozed},
+		},
+		{
+			name:   "diff size range",
+			filter: Filter{ShowSnoozed: true, MinDiffSize: 100, MaxDiffSize: 600},
+			want:   []int64{approvedByMe, plain},
+		},
+		{
+			name:   "text in title",
+			filter: Filter{ShowSnoozed: true, Text: " login "},
+			want:   []int64{plain},
+		},
+		{
+			name:   "text in description",
+			filter: Filter{ShowSnoozed: true, Text: "FLAKY"},
+			want:   []int64{snoozed},
+		},
+		{
+			name:   "tags",
+			filter: Filter{ShowSnoozed: true, Tags: []string{"later", "never"}},
+			want:   []int64{mineDraft},
+		},
+		{
+			name:   "not seen",
+			filter: Filter{ShowSnoozed: true, Seen: lo.ToPtr(false)},
+			want:   []int64{mineDraft, approvedByMe, snoozed},
+		},
+		{
+			name:   "pinned",
+			filter: Filter{ShowSnoozed: true, Pinned: lo.ToPtr(true)},
+			want:   []int64{plain},
+		},
+		{
+			name:   "all predicates must match",
+			filter: Filter{Authors: []string{"bob", "carol"}, PipelineStatuses: []string{"failed"}},
+			want:   []int64{approvedByMe},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups := filterMergeRequests(
+				[]MergeRequestsGroup{{MergeRequests: slices.Clone(mrs)}}, me, tt.filter, now,
+			)
+
+			got := lo.Map(groups[0].MergeRequests, func(item MergeRequest, _ int) int64 {
+				return item.IID
+			})
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("filterMergeRequests() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
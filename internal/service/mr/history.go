@@ -0,0 +1,243 @@
+package mr
+
+import (
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/vlanse/glmr/internal/service/store"
+)
+
+type TimelineChange string
+
+const (
+	TimelineChangeState     TimelineChange = "state"
+	TimelineChangeTitle     TimelineChange = "title"
+	TimelineChangeDraft     TimelineChange = "draft"
+	TimelineChangeConflict  TimelineChange = "conflict"
+	TimelineChangePipeline  TimelineChange = "pipeline"
+	TimelineChangeApprovals TimelineChange = "approvals"
+	TimelineChangeThreads   TimelineChange = "threads"
+	TimelineChangeDiff      TimelineChange = "diff"
+
+	closedState = "closed"
+)
+
+// TimelineEntry is a state of merge request recorded when it changed
+type TimelineEntry struct {
+	RecordedAt        time.Time
+	State             string // opened, merged or closed
+	Title             string
+	Draft             bool
+	Conflict          bool
+	PipelineStatus    string
+	ApprovedBy        []string // usernames
+	ApprovalsLeft     int
+	Threads           int // resolvable threads
+	UnresolvedThreads int
+	Additions         int64
+	Deletions         int64
+	Changes           []TimelineChange // changes since previous entry, empty for the first one
+}
+
+type Timeline struct {
+	ProjectID   int64
+	IID         int64
+	ProjectName string
+	URL         string
+	Author      string
+	CreatedAt   time.Time
+	Entries     []TimelineEntry // the oldest first
+}
+
+// GetTimeline returns recorded history of merge request, nil when merge request was never seen
+func (s *Service) GetTimeline(ctx context.Context, projectID, mergeRequestIID int64) (*Timeline, error) {
+	mr, states, err := s.storeSvc.GetTimeline(ctx, store.MergeRequestKey{ProjectID: projectID, IID: mergeRequestIID})
+	if err != nil || mr == nil {
+		return nil, err
+	}
+
+	res := &Timeline{
+		ProjectID:   mr.ProjectID,
+		IID:         mr.IID,
+		ProjectName: mr.ProjectName,
+		URL:         mr.URL,
+		Author:      mr.Author,
+		CreatedAt:   mr.CreatedAt,
+	}
+	for i, st := range states {
+		entry := TimelineEntry{
+			RecordedAt:        st.RecordedAt,
+			State:             st.State,
+			Title:             st.Title,
+			Draft:             st.Draft,
+			Conflict:          st.Conflict,
+			PipelineStatus:    st.PipelineStatus,
+			ApprovedBy:        st.ApprovedBy,
+			ApprovalsLeft:     st.ApprovalsLeft,
+			Threads:           st.Threads,
+			UnresolvedThreads: st.UnresolvedThreads,
+			Additions:         st.Additions,
+			Deletions:         st.Deletions,
+		}
+		if i > 0 {
+			entry.Changes = timelineChanges(states[i-1], st)
+		}
+		res.Entries = append(res.Entries, entry)
+	}
+	return res, nil
+}
+
+func timelineChanges(prev, cur store.MergeRequestState) []TimelineChange {
+	var res []TimelineChange
+	if prev.State != cur.State {
+		res = append(res, TimelineChangeState)
+	}
+	if prev.Title != cur.Title {
+		res = append(res, TimelineChangeTitle)
+	}
+	if prev.Draft != cur.Draft {
+		res = append(res, TimelineChangeDraft)
+	}
+	if prev.Conflict != cur.Conflict {
+		res = append(res, TimelineChangeConflict)
+	}
+	if prev.PipelineStatus != cur.PipelineStatus {
+		res = append(res, TimelineChangePipeline)
+	}
+	if !slices.Equal(prev.ApprovedBy, cur.ApprovedBy) || prev.ApprovalsLeft != cur.ApprovalsLeft {
+		res = append(res, TimelineChangeApprovals)
+	}
+	if prev.Threads != cur.Threads || prev.UnresolvedThreads != cur.UnresolvedThreads {
+		res = append(res, TimelineChangeThreads)
+	}
+	if prev.Additions != cur.Additions || prev.Deletions != cur.Deletions {
+		res = append(res, TimelineChangeDiff)
+	}
+	return res
+}
+
+// recordHistory saves states of merge requests of successfully fetched projects and of merge requests
+// which were merged or closed according to events
+func (s *Service) recordHistory(ctx context.Context, snap *snapshot, events []Event, now time.Time) {
+	fetched := fetchedProjectIDs(snap.projects)
+
+	var records []store.Record
+	for key, mr := range mergeRequestsByKey(snap.projects) {
+		if fetched[key.projectID] {
+			records = append(records, historyRecord(now, openedState, mr))
+		}
+	}
+	for _, e := range events {
+		switch e.Type {
+		case EventMerged:
+			r := historyRecord(now, mergedState, e.MergeRequest)
+			r.MergeRequest.MergedAt = e.MergedAt
+			records = append(records, r)
+		case EventClosed:
+			records = append(records, historyRecord(now, closedState, e.MergeRequest))
+		}
+	}
+
+	if err := s.storeSvc.RecordStates(ctx, records); err != nil {
+		log.Printf("could not record merge requests history: %v", err)
+	}
+}
+
+// reconcileHistory records merge requests which are opened according to history but are missing in snapshot,
+// they could be merged or closed while application was not running or their projects were removed from
+// configuration; merge requests of projects which failed to fetch are skipped
+func (s *Service) reconcileHistory(ctx context.Context, snap *snapshot, now time.Time) {
+	shown := mergeRequestsByKey(snap.projects)
+	failed := make(map[int64]bool)
+	for _, p := range snap.projects {
+		if p.Error != nil {
+			failed[p.ID] = true
+		}
+	}
+
+	var missing []mergeRequestKey
+	for _, key := range s.storeSvc.OpenedMergeRequests() {
+		k := mergeRequestKey{projectID: key.ProjectID, iid: key.IID}
+		if _, found := shown[k]; !found && !failed[k.projectID] {
+			missing = append(missing, k)
+		}
+	}
+	if len(missing) == 0 {
+		return
+	}
+
+	states := make(map[store.MergeRequestKey]store.FinalState)
+	for key, info := range s.lookupStates(ctx, missing) {
+		if info.State == mergedState || info.State == closedState {
+			states[store.MergeRequestKey{ProjectID: key.projectID, IID: key.iid}] = store.FinalState{
+				State:    info.State,
+				MergedAt: lo.FromPtr(info.MergedAt),
+			}
+		}
+	}
+	if err := s.storeSvc.RecordFinalStates(ctx, now, states); err != nil {
+		log.Printf("could not record merge requests history: %v", err)
+	}
+}
+
+func historyRecord(now time.Time, state string, mr MergeRequest) store.Record {
+	return store.Record{
+		MergeRequest: store.MergeRequest{
+			MergeRequestKey: store.MergeRequestKey{ProjectID: mr.Project.ID, IID: mr.IID},
+			ProjectName:     mr.Project.Name,
+			URL:             mr.URL,
+			Author:          mr.Author.Username,
+			CreatedAt:       mr.CreatedAt,
+		},
+		State: store.MergeRequestState{
+			RecordedAt:     now,
+			State:          state,
+			Title:          mr.Description,
+			Draft:          mr.Draft,
+			Conflict:       mr.Status.Conflict,
+			PipelineStatus: mr.Pipeline.Status,
+			ApprovedBy: lo.Map(mr.Approvals, func(item Approval, _ int) string {
+				return item.User.Username
+			}),
+			ApprovalsLeft: mr.ApprovalRequirements.Left,
+			Threads: lo.CountBy(mr.Discussions, func(d Discussion) bool {
+				return d.Resolvable
+			}),
+			UnresolvedThreads: countUnresolvedThreads(mr),
+			Additions:         mr.DiffStatsSummary.Additions,
+			Deletions:         mr.DiffStatsSummary.Deletions,
+		},
+		Reviews: reviews(mr),
+	}
+}
+
+// reviews are the first approvals or comments of users other than author
+func reviews(mr MergeRequest) []store.Review {
+	firstByUser := make(map[string]time.Time)
+	add := func(username string, at time.Time) {
+		if username == mr.Author.Username || at.IsZero() {
+			return
+		}
+		if first, found := firstByUser[username]; !found || at.Before(first) {
+			firstByUser[username] = at
+		}
+	}
+
+	for _, a := range mr.Approvals {
+		add(a.User.Username, a.ApprovedAt)
+	}
+	for _, d := range mr.Discussions {
+		for _, n := range d.Notes {
+			if !n.System {
+				add(n.Author.Username, n.CreatedAt)
+			}
+		}
+	}
+
+	return lo.MapToSlice(firstByUser, func(username string, at time.Time) store.Review {
+		return store.Review{Username: username, ReviewedAt: at}
+	})
+}
@@ -0,0 +1,41 @@
+package mr
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/vlanse/glmr/internal/service/store"
+)
+
+// fillLocalState sets marks, private notes and tags of merge requests from local store,
+// merge requests are shown without them when store is not available
+func (s *Service) fillLocalState(ctx context.Context, now time.Time, groups []MergeRequestsGroup) []MergeRequestsGroup {
+	marks, err := s.storeSvc.GetMarks(ctx)
+	if err != nil {
+		log.Printf("could not get merge request marks: %v", err)
+	}
+	annotations, err := s.storeSvc.GetAnnotations(ctx)
+	if err != nil {
+		log.Printf("could not get merge request annotations: %v", err)
+	}
+
+	for i, g := range groups {
+		for j, mr := range g.MergeRequests {
+			key := store.MergeRequestKey{ProjectID: mr.Project.ID, IID: mr.IID}
+			if mark, found := marks[key]; found {
+				groups[i].MergeRequests[j].Marks = marksOf(now, mr, mark)
+			}
+			if a, found := annotations[key]; found {
+				groups[i].MergeRequests[j].PrivateNote = a.Note
+				groups[i].MergeRequests[j].Tags = a.Tags
+			}
+		}
+	}
+	return groups
+}
+
+// withLocalState returns merge request with marks, private note and tags from local store
+func (s *Service) withLocalState(ctx context.Context, now time.Time, mr MergeRequest) MergeRequest {
+	return s.fillLocalState(ctx, now, []MergeRequestsGroup{{MergeRequests: []MergeRequest{mr}}})[0].MergeRequests[0]
+}
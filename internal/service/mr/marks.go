@@ -0,0 +1,151 @@
+package mr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/vlanse/glmr/internal/service/store"
+)
+
+var (
+	// ErrNotShown is returned by actions which need merge request from dashboard when it is not there
+	ErrNotShown = errors.New("merge request is not shown on dashboard")
+	// ErrActivityUnknown is returned when mark depends on merge request activity which could not be fetched
+	ErrActivityUnknown = errors.New("activity of merge request is not known, discussions could not be fetched")
+)
+
+// Marks are local state of merge request set by the current user, they survive restarts
+type Marks struct {
+	Seen         bool // marked as seen and has no new commits, comments or approvals since then
+	Pinned       bool // pinned merge requests go first in the group
+	Snoozed      bool
+	SnoozedUntil time.Time // zero when merge request is snoozed until the next change or is not snoozed
+}
+
+// MarkSeen marks merge request as seen until its next commit, comment or approval, or clears the mark
+func (s *Service) MarkSeen(ctx context.Context, projectID, mergeRequestIID int64, seen bool) (*MergeRequest, error) {
+	return s.updateMarks(ctx, projectID, mergeRequestIID, seen, func(mark store.Mark, activity string) store.Mark {
+		mark.SeenActivity = ""
+		if seen {
+			mark.SeenActivity = activity
+		}
+		return mark
+	})
+}
+
+// Snooze hides merge request until the given time or, when untilChange is set, until its next commit,
+// comment or approval; zero until without untilChange wakes merge request up
+func (s *Service) Snooze(
+	ctx context.Context, projectID, mergeRequestIID int64, until time.Time, untilChange bool,
+) (*MergeRequest, error) {
+	return s.updateMarks(ctx, projectID, mergeRequestIID, untilChange, func(mark store.Mark, activity string) store.Mark {
+		mark.SnoozedUntil = until
+		mark.SnoozedActivity = ""
+		if untilChange {
+			mark.SnoozedActivity = activity
+		}
+		return mark
+	})
+}
+
+func (s *Service) Pin(ctx context.Context, projectID, mergeRequestIID int64, pinned bool) (*MergeRequest, error) {
+	return s.updateMarks(ctx, projectID, mergeRequestIID, false, func(mark store.Mark, _ string) store.Mark {
+		mark.Pinned = pinned
+		return mark
+	})
+}
+
+// updateMarks applies update to stored marks of merge request, needsActivity is set
+// when update relies on the current activity of merge request
+func (s *Service) updateMarks(
+	ctx context.Context, projectID, mergeRequestIID int64, needsActivity bool,
+	update func(mark store.Mark, activity string) store.Mark,
+) (*MergeRequest, error) {
+	mr := s.findMergeRequest(projectID, mergeRequestIID)
+	if mr == nil {
+		return nil, fmt.Errorf("merge request %d!%d: %w", projectID, mergeRequestIID, ErrNotShown)
+	}
+
+	act, known := activity(*mr)
+	if needsActivity && !known {
+		return nil, fmt.Errorf("merge request %d!%d: %w", projectID, mergeRequestIID, ErrActivityUnknown)
+	}
+	if err := s.storeSvc.UpdateMark(
+		ctx, store.MergeRequestKey{ProjectID: projectID, IID: mergeRequestIID},
+		func(mark store.Mark) store.Mark {
+			return update(mark, act)
+		},
+	); err != nil {
+		return nil, err
+	}
+	return lo.ToPtr(s.withLocalState(ctx, time.Now(), *mr)), nil
+}
+
+// findMergeRequest returns copy of merge request from the current snapshot, nil when it is not there
+func (s *Service) findMergeRequest(projectID, mergeRequestIID int64) *MergeRequest {
+	snap := s.loadSnapshot()
+	if snap == nil {
+		return nil
+	}
+	for _, p := range snap.projects {
+		if p.ID != projectID {
+			continue
+		}
+		if mr, found := lo.Find(p.MergeRequests, func(item MergeRequest) bool {
+			return item.IID == mergeRequestIID
+		}); found {
+			return &mr
+		}
+	}
+	return nil
+}
+
+func marksOf(now time.Time, mr MergeRequest, mark store.Mark) Marks {
+	act, known := activity(mr)
+	// merge request is considered unchanged while its activity is not known
+	unchanged := func(markActivity string) bool {
+		return len(markActivity) > 0 && (!known || markActivity == act)
+	}
+
+	res := Marks{
+		Seen:   unchanged(mark.SeenActivity),
+		Pinned: mark.Pinned,
+	}
+	if mark.SnoozedUntil.After(now) {
+		res.Snoozed = true
+		res.SnoozedUntil = mark.SnoozedUntil
+	}
+	if unchanged(mark.SnoozedActivity) {
+		res.Snoozed = true
+	}
+	return res
+}
+
+// activity is a fingerprint of merge request commits, comments and approvals,
+// it is not known when discussions of merge request could not be fetched
+func activity(mr MergeRequest) (string, bool) {
+	if !mr.discussionsLoaded {
+		return "", false
+	}
+
+	// note IDs grow, so the latest comment changes with every new one and with deletion of the latest one
+	var latestNoteID int64
+	for _, d := range mr.Discussions {
+		for _, n := range d.Notes {
+			if !n.System {
+				latestNoteID = max(latestNoteID, n.ID)
+			}
+		}
+	}
+	approvedBy := lo.Map(mr.Approvals, func(item Approval, _ int) string {
+		return item.User.Username
+	})
+	slices.Sort(approvedBy)
+
+	return fmt.Sprintf("%s/%d/%s", mr.HeadSHA, latestNoteID, strings.Join(approvedBy, ",")), true
+}
@@ -0,0 +1,89 @@
+package mr
+
+import (
+	"slices"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const approvedNoteBody = "approved this merge request"
+
+// fillApprovalTimes sets approval time from the latest system note about approval of the same user
+func fillApprovalTimes(projects []Project) []Project {
+	for i, project := range projects {
+		for j, mr := range project.MergeRequests {
+			for k, a := range mr.Approvals {
+				for _, d := range mr.Discussions {
+					for _, n := range d.Notes {
+						if !n.System || n.Body != approvedNoteBody || n.Author.Username != a.User.Username {
+							continue
+						}
+						if n.CreatedAt.After(a.ApprovedAt) {
+							a.ApprovedAt = n.CreatedAt
+						}
+					}
+				}
+				projects[i].MergeRequests[j].Approvals[k].ApprovedAt = a.ApprovedAt
+			}
+		}
+	}
+	return projects
+}
+
+func fillReviewMetrics(projects []Project) []Project {
+	for i, project := range projects {
+		for j, mr := range project.MergeRequests {
+			metrics := ReviewMetrics{
+				TimeToFirstComment:  timeToFirstComment(mr),
+				TimeToFirstApproval: timeToApprovals(mr, 1),
+			}
+			if mr.ApprovalRequirements.Left == 0 {
+				metrics.TimeToRequiredApprovals = timeToApprovals(mr, mr.ApprovalRequirements.Required)
+			}
+			projects[i].MergeRequests[j].ReviewMetrics = metrics
+		}
+	}
+	return projects
+}
+
+func timeToFirstComment(mr MergeRequest) time.Duration {
+	var first time.Time
+	for _, d := range mr.Discussions {
+		for _, n := range d.Notes {
+			if n.System || n.Author.Username == mr.Author.Username {
+				continue
+			}
+			if first.IsZero() || n.CreatedAt.Before(first) {
+				first = n.CreatedAt
+			}
+		}
+	}
+	return sinceCreated(mr, first)
+}
+
+// timeToApprovals returns time it took to get given number of approvals,
+// zero when merge request still has not enough approvals or approval times are unknown
+func timeToApprovals(mr MergeRequest, count int) time.Duration {
+	if count <= 0 {
+		return 0
+	}
+
+	approvedAt := lo.FilterMap(mr.Approvals, func(item Approval, _ int) (time.Time, bool) {
+		return item.ApprovedAt, !item.ApprovedAt.IsZero()
+	})
+	if len(approvedAt) < count {
+		return 0
+	}
+	slices.SortFunc(approvedAt, func(a, b time.Time) int {
+		return a.Compare(b)
+	})
+	return sinceCreated(mr, approvedAt[count-1])
+}
+
+func sinceCreated(mr MergeRequest, t time.Time) time.Duration {
+	if t.IsZero() {
+		return 0
+	}
+	return max(t.Sub(mr.CreatedAt), time.Nanosecond)
+}
@@ -1,21 +1,49 @@
 package mr
 
-import "github.com/samber/lo"
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/samber/lo"
+)
 
 type ProjectSettings struct {
 	Name string
 	ID   int64
+	SLA  *SLASettings // overrides limits of the group
 }
 
-type ProjectGroupSettings struct {
-	Name     string
-	Projects []ProjectSettings
+// NamespaceSettings describes gitlab group (namespace) which projects are discovered automatically
+type NamespaceSettings struct {
+	Path             string
+	IncludeSubgroups bool
+	IncludeArchived  bool
+	// Include and Exclude are glob patterns matched against project name or path,
+	// all projects are included when Include is empty
+	Include []string
+	Exclude []string
 }
 
-func (g ProjectGroupSettings) GetAllProjectIDs() []int64 {
-	return lo.Map(g.Projects, func(item ProjectSettings, _ int) int64 {
-		return item.ID
-	})
+func (n NamespaceSettings) Matches(name, path string) bool {
+	matches := func(patterns []string) bool {
+		return lo.SomeBy(patterns, func(pattern string) bool {
+			nameMatched, _ := filepath.Match(pattern, name)
+			pathMatched, _ := filepath.Match(pattern, path)
+			return nameMatched || pathMatched
+		})
+	}
+
+	if len(n.Include) > 0 && !matches(n.Include) {
+		return false
+	}
+	return !matches(n.Exclude)
+}
+
+type ProjectGroupSettings struct {
+	Name       string
+	Projects   []ProjectSettings
+	Namespaces []NamespaceSettings
+	SLA        *SLASettings // overrides default limits
 }
 
 func (g ProjectGroupSettings) ProjectByID(id int64) (ProjectSettings, bool) {
@@ -24,25 +52,49 @@ func (g ProjectGroupSettings) ProjectByID(id int64) (ProjectSettings, bool) {
 	})
 }
 
+// DynamicGroupType defines which merge requests related to the current user make up dynamic group
+type DynamicGroupType string
+
+const (
+	DynamicGroupReviewRequested DynamicGroupType = "reviewRequested"
+	DynamicGroupAssigned        DynamicGroupType = "assigned"
+	DynamicGroupAuthored        DynamicGroupType = "authored"
+)
+
+// DynamicGroupSettings describes group of merge requests queried across all projects of gitlab instance
+type DynamicGroupSettings struct {
+	Name string
+	Type DynamicGroupType
+	SLA  *SLASettings // overrides default limits
+}
+
 type JIRA struct {
 	URL string
 }
 
 type Settings struct {
-	Groups []ProjectGroupSettings
-	JIRA   JIRA
-}
-
-func (s *Settings) GetProjects() []Project {
-	var projects []Project
-	for _, group := range s.Groups {
-		for _, project := range group.Projects {
-			projects = append(projects, Project{
-				ID:        project.ID,
-				Name:      project.Name,
-				GroupName: group.Name,
-			})
+	Groups          []ProjectGroupSettings
+	DynamicGroups   []DynamicGroupSettings
+	JIRA            JIRA
+	RefreshInterval time.Duration
+	// SLA are default limits for all groups, merge requests older than 10 days are overdue when not set
+	SLA      *SLASettings
+	Calendar CalendarSettings
+	Rules    []RuleSettings
+
+	ReviewerSuggestions ReviewerSuggestionSettings
+}
+
+func (s *Settings) defaultSLA() SLASettings {
+	return SLASettings{MaxAge: lo.ToPtr(defaultMaxAge)}.overriddenBy(s.SLA)
+}
+
+// projectSLA returns limits of project overridden in any group
+func (s *Settings) projectSLA(projectID int64) *SLASettings {
+	for _, g := range s.Groups {
+		if p, found := g.ProjectByID(projectID); found && p.SLA != nil {
+			return p.SLA
 		}
 	}
-	return projects
+	return nil
 }
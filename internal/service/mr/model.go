@@ -2,11 +2,31 @@ package mr
 
 import "time"
 
+// Filter selects merge requests to show, criteria are combined with AND, values of list criteria are combined with OR,
+// empty criteria match any merge request
 type Filter struct {
 	SkipApprovedByMe bool
 	ButStillShowMine bool
 	ShowOnlyMine     bool
 	DoNotShowDrafts  bool
+
+	Authors          []string // usernames
+	Reviewers        []string // usernames
+	Labels           []string
+	TargetBranches   []string
+	PipelineStatuses []string // lowercase gitlab pipeline statuses, i.e. "failed", noPipelineStatus matches MRs without pipeline
+	Conflict         *bool
+	HasUnresolved    *bool // merge request has unresolved threads
+	MinAge           time.Duration
+	MaxAge           time.Duration
+	MinDiffSize      int64 // additions + deletions
+	MaxDiffSize      int64
+	Text             string // case-insensitive search over title and description
+
+	Seen        *bool
+	Pinned      *bool
+	ShowSnoozed bool     // snoozed merge requests are hidden unless set
+	Tags        []string // private tags
 }
 
 type User struct {
@@ -29,8 +49,10 @@ type Commit struct {
 }
 
 type Note struct {
+	ID         int64
 	Author     User
 	ResolvedBy User
+	System     bool // note is generated by gitlab, i.e. on approval
 	Resolvable bool
 	Resolved   bool
 	CreatedAt  time.Time
@@ -39,11 +61,36 @@ type Note struct {
 }
 
 type Discussion struct {
-	Notes []Note
+	ID         string
+	Resolvable bool
+	Resolved   bool
+	Notes      []Note
+}
+
+// ReviewMetrics are measured from merge request creation, zero value means event has not happened yet
+type ReviewMetrics struct {
+	TimeToFirstComment      time.Duration // first comment of somebody except author
+	TimeToFirstApproval     time.Duration
+	TimeToRequiredApprovals time.Duration
+}
+
+type Job struct {
+	ID            int64
+	Name          string
+	Stage         string
+	Status        string
+	WebURL        string
+	Duration      time.Duration
+	AllowFailure  bool
+	FailureReason string
 }
 
 type Pipeline struct {
-	Status string
+	ID         int64 // 0 when merge request has no pipeline
+	Status     string
+	WebURL     string
+	Duration   time.Duration
+	FailedJobs []Job // set for failed pipelines only
 }
 
 type CommentStats struct {
@@ -54,9 +101,10 @@ type CommentStats struct {
 type Status struct {
 	Conflict       bool
 	PipelineFailed bool
-	Ready          bool
-	Outdated       bool
+	Ready          bool // pipeline succeeded, there are no conflicts and all required approvals are given
+	Outdated       bool // some SLA is violated
 	Pending        bool
+	SLAViolations  []SLAViolation
 }
 
 type Issue struct {
@@ -64,6 +112,32 @@ type Issue struct {
 	URL string
 }
 
+// ApprovalRequirements describes approval requirements of merge request
+type ApprovalRequirements struct {
+	Required       int
+	Left           int
+	ApprovableByMe bool
+	Rules          []ApprovalRule // empty when gitlab instance does not provide approval rules of merge request
+}
+
+type Label struct {
+	Title string
+	Color string
+}
+
+type Milestone struct {
+	Title   string
+	WebURL  string
+	DueDate time.Time // zero when not set
+}
+
+// Highlight is assigned to merge request by user-defined rule
+type Highlight struct {
+	Name     string
+	Color    string
+	Priority int
+}
+
 type DiffStatsSummary struct {
 	Additions int64
 	Deletions int64
@@ -71,26 +145,62 @@ type DiffStatsSummary struct {
 }
 
 type MergeRequest struct {
-	IID              int64 // "short" gitlab ID
-	Project          Project
-	CreatedAt        time.Time
-	Description      string
-	URL              string
-	Author           User
-	Approvals        []Approval
-	Commits          []Commit
-	Pipeline         Pipeline
-	Discussions      []Discussion
-	CommentStats     CommentStats
-	Status           Status
-	ApprovedBefore   bool
-	Issues           []Issue
-	DiffStatsSummary DiffStatsSummary
+	IID                  int64 // "short" gitlab ID
+	Project              Project
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	Description          string // title of merge request
+	Body                 string // description of merge request
+	Draft                bool
+	URL                  string
+	SourceBranch         string
+	TargetBranch         string
+	HeadSHA              string // the latest commit of source branch
+	Labels               []Label
+	Milestone            *Milestone // nil when merge request has no milestone
+	Author               User
+	Reviewers            []User
+	Assignees            []User
+	Approvals            []Approval
+	ApprovalRequirements ApprovalRequirements
+	Commits              []Commit
+	Pipeline             Pipeline
+	Discussions          []Discussion
+	CommentStats         CommentStats
+	Status               Status
+	ApprovedBefore       bool
+	Issues               []Issue
+	DiffStatsSummary     DiffStatsSummary
+	ReviewMetrics        ReviewMetrics
+	Highlights           []Highlight          // sorted by priority, the highest first
+	ReviewPriority       float64              // how urgently the current user should review merge request, within [0, 100]
+	SuggestedReviewers   []ReviewerSuggestion // set for merge requests without review activity, the best first
+	Marks                Marks                // local state set by the current user
+	PrivateNote          string               // markdown note of the current user, kept locally
+	Tags                 []string             // free-form tags of the current user, kept locally
+	Warnings             []string             // details which could not be fetched, merge request is shown without them
+
+	// discussionsLoaded is set when all discussions are known, they came along with MR or were fetched separately
+	discussionsLoaded bool
+	// approvalsLoaded is set when approval requirements are known, they came along with MR or were fetched separately
+	approvalsLoaded bool
 }
 
 type ApprovalRule struct {
 	Name  string
-	Users []User
+	Type  string
+	Users []User // eligible approvers
+
+	// fields below are set for rules of merge request only
+	ApprovalsRequired int
+	Approved          bool
+	ApprovedBy        []User
+}
+
+type ProjectError struct {
+	StatusCode    int // HTTP status code of failed gitlab request, 0 when unknown
+	Message       string
+	LastSuccessAt time.Time // zero when project was never fetched successfully
 }
 
 type Project struct {
@@ -101,6 +211,9 @@ type Project struct {
 	PathWithNamespace string
 	MergeRequests     []MergeRequest
 	ApprovalRules     []ApprovalRule
+	Error             *ProjectError // set when project data could not be fetched from gitlab
+
+	sla SLASettings
 }
 
 type Summary struct {
@@ -110,7 +223,13 @@ type Summary struct {
 	OverdueVisible int
 }
 type MergeRequestsGroup struct {
-	GroupName     string
-	MergeRequests []MergeRequest
-	Summary       Summary
+	GroupName      string
+	MergeRequests  []MergeRequest
+	Summary        Summary
+	FailedProjects []Project
+}
+
+type MergeRequestsResult struct {
+	Groups    []MergeRequestsGroup
+	UpdatedAt time.Time
 }
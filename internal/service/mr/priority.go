@@ -0,0 +1,77 @@
+package mr
+
+import (
+	"math"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+// weights of review priority components, the score of merge request is within [0, 100]
+const (
+	stalenessWeight        = 30.0
+	requiredApproverWeight = 30.0
+	eligibleApproverWeight = 10.0
+	smallDiffWeight        = 20.0
+	greenPipelineWeight    = 20.0
+
+	// staleAfter is idle time after which merge request gets the maximum staleness component
+	staleAfter = 72 * time.Hour
+	// halfDiffSize is diff size (additions + deletions) which gets a half of small diff component
+	halfDiffSize = 200.0
+)
+
+// fillReviewPriorities scores how urgently the current user should review merge requests,
+// merge requests which are not to be reviewed by the current user get zero
+func fillReviewPriorities(now time.Time, currentUserName string, projects []Project) []Project {
+	for i, project := range projects {
+		for j, mr := range project.MergeRequests {
+			projects[i].MergeRequests[j].ReviewPriority = reviewPriority(now, currentUserName, mr)
+		}
+	}
+	return projects
+}
+
+func reviewPriority(now time.Time, currentUserName string, mr MergeRequest) float64 {
+	if mr.Author.Username == currentUserName || mr.Draft {
+		return 0
+	}
+	if lo.ContainsBy(mr.Approvals, func(item Approval) bool {
+		return item.User.Username == currentUserName
+	}) {
+		return 0
+	}
+
+	var score float64
+
+	idle := now.Sub(mr.UpdatedAt)
+	score += stalenessWeight * math.Min(float64(idle)/float64(staleAfter), 1)
+
+	requiredApprover := lo.ContainsBy(mr.ApprovalRequirements.Rules, func(r ApprovalRule) bool {
+		return r.ApprovalsRequired > 0 && !r.Approved && lo.ContainsBy(r.Users, func(u User) bool {
+			return u.Username == currentUserName
+		})
+	})
+	switch {
+	case requiredApprover:
+		score += requiredApproverWeight
+	case mr.ApprovalRequirements.ApprovableByMe:
+		score += eligibleApproverWeight
+	}
+
+	score += smallDiffWeight * halfDiffSize / (halfDiffSize + float64(diffSize(mr)))
+
+	switch mr.Pipeline.Status {
+	case pipelineSuccessStatus:
+		score += greenPipelineWeight
+	case pipelineFailedStatus:
+	default: // running or no pipeline at all
+		score += greenPipelineWeight / 2
+	}
+
+	if mr.Status.Conflict {
+		score /= 2
+	}
+
+	return math.Round(score*10) / 10
+}
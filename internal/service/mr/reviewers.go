@@ -0,0 +1,310 @@
+package mr
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/vlanse/glmr/internal/service/gitlab"
+)
+
+const (
+	defaultSuggestedReviewersCount = 3
+	// reviewHistoryWindow is how long reviews of project count as familiarity with it
+	reviewHistoryWindow = 30 * 24 * time.Hour
+	// familiarReviewCount is number of recent reviews of project which gives the maximum familiarity score
+	familiarReviewCount = 5
+
+	eligibleApproverScore = 1.0
+	codeOwnerScore        = 2.0
+	familiarityScore      = 1.0
+)
+
+type ReviewerSuggestionSettings struct {
+	Count int // number of suggested reviewers, defaultSuggestedReviewersCount when zero
+	// CodeOwners enables matching of changed files against CODEOWNERS, it costs two gitlab requests per merge request
+	CodeOwners bool
+}
+
+func (s ReviewerSuggestionSettings) count() int {
+	if s.Count > 0 {
+		return s.Count
+	}
+	return defaultSuggestedReviewersCount
+}
+
+// ReviewerSuggestion is a candidate reviewer, score grows with eligibility, CODEOWNERS match and recent reviews
+// of the project and is divided by current review load
+type ReviewerSuggestion struct {
+	User          User
+	Score         float64
+	OpenReviews   int  // opened merge requests where user is a reviewer
+	RecentReviews int  // merge requests of the project reviewed by user recently
+	CodeOwner     bool // user owns changed files according to CODEOWNERS
+}
+
+// AssignReviewer adds reviewer to merge request keeping already assigned ones
+func (s *Service) AssignReviewer(ctx context.Context, projectID, mergeRequestIID int64, username string) (*MergeRequest, error) {
+	user, err := s.gitlabSvc.GetUserByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	info, err := s.gitlabSvc.GetMergeRequestInfo(ctx, projectID, mergeRequestIID)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewerIDs := lo.Map(info.Reviewers, func(item gitlab.User, _ int) int64 {
+		return item.ID
+	})
+	if !slices.Contains(reviewerIDs, user.ID) {
+		if err = s.gitlabSvc.SetMergeRequestReviewers(
+			ctx, projectID, mergeRequestIID, append(reviewerIDs, user.ID),
+		); err != nil {
+			return nil, err
+		}
+	}
+	return s.refreshAfterAction(ctx, projectID, mergeRequestIID), nil
+}
+
+// suggestReviewers fills reviewer suggestions of merge requests without review activity,
+// review load and known users are taken from all projects
+func (s *Service) suggestReviewers(
+	ctx context.Context, settings ReviewerSuggestionSettings, projects, all []Project,
+) []Project {
+	recentReviews, err := s.storeSvc.GetReviewCounts(ctx, time.Now().Add(-reviewHistoryWindow))
+	if err != nil {
+		log.Printf("could not get recent reviews, suggestions do not account them: %v", err)
+	}
+
+	var ownersByMR map[mergeRequestKey][]string
+	if settings.CodeOwners {
+		ownersByMR = s.getCodeOwners(ctx, projects)
+	}
+
+	load := openReviewLoad(all)
+	users := knownUsers(all)
+
+	for i, p := range projects {
+		for j, mr := range p.MergeRequests {
+			if hasReviewActivity(mr) {
+				projects[i].MergeRequests[j].SuggestedReviewers = nil
+				continue
+			}
+
+			candidates := make(map[string]*ReviewerSuggestion)
+			candidate := func(u User) *ReviewerSuggestion {
+				if candidates[u.Username] == nil {
+					candidates[u.Username] = &ReviewerSuggestion{
+						User:          u,
+						OpenReviews:   load[u.Username],
+						RecentReviews: recentReviews[p.ID][u.Username],
+					}
+				}
+				return candidates[u.Username]
+			}
+
+			for _, r := range slices.Concat(mr.ApprovalRequirements.Rules, p.ApprovalRules) {
+				for _, u := range r.Users {
+					candidate(u).Score = eligibleApproverScore
+				}
+			}
+			for _, owner := range ownersByMR[mergeRequestKey{projectID: p.ID, iid: mr.IID}] {
+				if u, found := users[owner]; found {
+					candidate(u).CodeOwner = true
+				}
+			}
+			delete(candidates, mr.Author.Username)
+
+			suggestions := lo.MapToSlice(candidates, func(_ string, c *ReviewerSuggestion) ReviewerSuggestion {
+				if c.CodeOwner {
+					c.Score += codeOwnerScore
+				}
+				c.Score += familiarityScore * float64(min(c.RecentReviews, familiarReviewCount)) / familiarReviewCount
+				c.Score /= float64(1 + c.OpenReviews)
+				return *c
+			})
+			sort.Slice(suggestions, func(i, j int) bool {
+				if suggestions[i].Score != suggestions[j].Score {
+					return suggestions[i].Score > suggestions[j].Score
+				}
+				return suggestions[i].User.Username < suggestions[j].User.Username
+			})
+			projects[i].MergeRequests[j].SuggestedReviewers = lo.Subset(suggestions, 0, uint(settings.count()))
+		}
+	}
+	return projects
+}
+
+// hasReviewActivity reports whether merge request has reviewers, approvals or comments of someone except author
+func hasReviewActivity(mr MergeRequest) bool {
+	if len(mr.Reviewers) > 0 || len(mr.Approvals) > 0 {
+		return true
+	}
+	return lo.SomeBy(mr.Discussions, func(d Discussion) bool {
+		return lo.SomeBy(d.Notes, func(n Note) bool {
+			return !n.System && n.Author.Username != mr.Author.Username
+		})
+	})
+}
+
+// openReviewLoad counts opened merge requests by reviewer
+func openReviewLoad(projects []Project) map[string]int {
+	res := make(map[string]int)
+	for _, mr := range mergeRequestsByKey(projects) {
+		for _, r := range mr.Reviewers {
+			res[r.Username]++
+		}
+	}
+	return res
+}
+
+func knownUsers(projects []Project) map[string]User {
+	res := make(map[string]User)
+	add := func(users ...User) {
+		for _, u := range users {
+			if _, found := res[u.Username]; !found && len(u.Username) > 0 {
+				res[u.Username] = u
+			}
+		}
+	}
+	for _, p := range projects {
+		for _, r := range p.ApprovalRules {
+			add(r.Users...)
+		}
+		for _, mr := range p.MergeRequests {
+			add(mr.Author)
+			add(mr.Reviewers...)
+			add(mr.Assignees...)
+			for _, r := range mr.ApprovalRequirements.Rules {
+				add(r.Users...)
+			}
+		}
+	}
+	return res
+}
+
+// getCodeOwners returns owners of changed files of merge requests without review activity,
+// CODEOWNERS of target branch is used; failures are logged only since suggestions are optional
+func (s *Service) getCodeOwners(ctx context.Context, projects []Project) map[mergeRequestKey][]string {
+	type branchKey struct {
+		projectID int64
+		branch    string
+	}
+
+	var mx sync.Mutex
+	codeOwnersByBranch := make(map[branchKey]codeOwners)
+	filesByMR := make(map[mergeRequestKey][]string)
+
+	group := s.pool.NewGroup()
+	for _, p := range projects {
+		for _, mr := range p.MergeRequests {
+			if hasReviewActivity(mr) {
+				continue
+			}
+
+			key := branchKey{projectID: p.ID, branch: mr.TargetBranch}
+			mx.Lock()
+			_, found := codeOwnersByBranch[key]
+			if !found {
+				codeOwnersByBranch[key] = nil
+			}
+			mx.Unlock()
+			if !found {
+				group.Submit(func() {
+					owners := s.getCodeOwnersFile(ctx, key.projectID, key.branch)
+					mx.Lock()
+					defer mx.Unlock()
+					codeOwnersByBranch[key] = owners
+				})
+			}
+
+			group.Submit(func() {
+				files, err := s.getChangedFiles(ctx, p.ID, mr)
+				if err != nil {
+					log.Printf("could not get changed files of merge request %s: %v", mr.URL, err)
+					return
+				}
+				mx.Lock()
+				defer mx.Unlock()
+				filesByMR[mergeRequestKey{projectID: p.ID, iid: mr.IID}] = files
+			})
+		}
+	}
+
+	_ = group.Wait()
+
+	res := make(map[mergeRequestKey][]string)
+	for _, p := range projects {
+		for _, mr := range p.MergeRequests {
+			key := mergeRequestKey{projectID: p.ID, iid: mr.IID}
+			if files, found := filesByMR[key]; found {
+				res[key] = codeOwnersByBranch[branchKey{projectID: p.ID, branch: mr.TargetBranch}].owners(files)
+			}
+		}
+	}
+	return res
+}
+
+// changedFiles are paths changed by merge request as of its head commit
+type changedFiles struct {
+	headSHA string
+	files   []string
+}
+
+// getChangedFiles returns paths changed by merge request, they are fetched again after every push only
+func (s *Service) getChangedFiles(ctx context.Context, projectID int64, mr MergeRequest) ([]string, error) {
+	key := mergeRequestKey{projectID: projectID, iid: mr.IID}
+
+	s.changedFilesMx.Lock()
+	cached, found := s.changedFilesByMR[key]
+	s.changedFilesMx.Unlock()
+	if found && cached.headSHA == mr.HeadSHA {
+		return cached.files, nil
+	}
+
+	diffs, err := s.gitlabSvc.GetMergeRequestDiffs(ctx, projectID, mr.IID)
+	if err != nil {
+		return nil, err
+	}
+	files := lo.Uniq(lo.FlatMap(diffs, func(item gitlab.Diff, _ int) []string {
+		return []string{item.OldPath, item.NewPath}
+	}))
+
+	s.changedFilesMx.Lock()
+	defer s.changedFilesMx.Unlock()
+	s.changedFilesByMR[key] = changedFiles{headSHA: mr.HeadSHA, files: files}
+	return files, nil
+}
+
+// forgetChangedFiles drops changed files of merge requests which are not among projects anymore
+func (s *Service) forgetChangedFiles(projects []Project) {
+	mrs := mergeRequestsByKey(projects)
+
+	s.changedFilesMx.Lock()
+	defer s.changedFilesMx.Unlock()
+	for key := range s.changedFilesByMR {
+		if _, found := mrs[key]; !found {
+			delete(s.changedFilesByMR, key)
+		}
+	}
+}
+
+func (s *Service) getCodeOwnersFile(ctx context.Context, projectID int64, branch string) codeOwners {
+	for _, path := range codeOwnersPaths {
+		data, err := s.gitlabSvc.GetRawFile(ctx, projectID, path, branch)
+		if err == nil {
+			return parseCodeOwners(data)
+		}
+		if gitlab.ErrorStatusCode(err) != http.StatusNotFound {
+			log.Printf("could not get %s of project %d: %v", path, projectID, err)
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package mr
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+	"github.com/samber/lo"
+)
+
+// RuleSettings assigns highlight to merge requests matching boolean expression,
+// see ruleEnv for fields available in expression
+type RuleSettings struct {
+	Name       string
+	Expression string
+	Color      string
+	Priority   int
+}
+
+type rule struct {
+	settings RuleSettings
+	program  *vm.Program
+}
+
+// ruleEnv is merge request as it is seen by rule expressions
+type ruleEnv struct {
+	Title         string        `expr:"title"`
+	Author        string        `expr:"author"`
+	Mine          bool          `expr:"mine"`
+	Draft         bool          `expr:"draft"`
+	Project       string        `expr:"project"`
+	Group         string        `expr:"group"`
+	Labels        []string      `expr:"labels"`
+	Milestone     string        `expr:"milestone"`
+	Reviewers     []string      `expr:"reviewers"`
+	Assignees     []string      `expr:"assignees"`
+	SourceBranch  string        `expr:"sourceBranch"`
+	TargetBranch  string        `expr:"targetBranch"`
+	Age           time.Duration `expr:"age"`
+	Idle          time.Duration `expr:"idle"`
+	Additions     int64         `expr:"additions"`
+	Deletions     int64         `expr:"deletions"`
+	Files         int64         `expr:"files"`
+	Approvals     int           `expr:"approvals"`
+	ApprovalsLeft int           `expr:"approvalsLeft"`
+	ApprovedByMe  bool          `expr:"approvedByMe"`
+	Unresolved    int           `expr:"unresolved"`
+	Pipeline      string        `expr:"pipeline"`
+	Conflict      bool          `expr:"conflict"`
+	Overdue       bool          `expr:"overdue"`
+}
+
+func newRuleEnv(now time.Time, mr MergeRequest) ruleEnv {
+	return ruleEnv{
+		Title:         mr.Description,
+		Author:        mr.Author.Username,
+		Mine:          mr.Author.IsMe,
+		Draft:         mr.Draft,
+		Project:       mr.Project.Name,
+		Group:         mr.Project.GroupName,
+		Labels:        lo.Map(mr.Labels, func(item Label, _ int) string { return item.Title }),
+		Milestone:     lo.FromPtr(mr.Milestone).Title,
+		Reviewers:     lo.Map(mr.Reviewers, usernameOf),
+		Assignees:     lo.Map(mr.Assignees, usernameOf),
+		SourceBranch:  mr.SourceBranch,
+		TargetBranch:  mr.TargetBranch,
+		Age:           now.Sub(mr.CreatedAt),
+		Idle:          now.Sub(mr.UpdatedAt),
+		Additions:     mr.DiffStatsSummary.Additions,
+		Deletions:     mr.DiffStatsSummary.Deletions,
+		Files:         mr.DiffStatsSummary.FileCount,
+		Approvals:     len(mr.Approvals),
+		ApprovalsLeft: mr.ApprovalRequirements.Left,
+		ApprovedByMe: lo.SomeBy(mr.Approvals, func(item Approval) bool {
+			return item.User.IsMe
+		}),
+		Unresolved: mr.CommentStats.UnresolvedCount,
+		Pipeline:   mr.Pipeline.Status,
+		Conflict:   mr.Status.Conflict,
+		Overdue:    mr.Status.Outdated,
+	}
+}
+
+func usernameOf(u User, _ int) string {
+	return u.Username
+}
+
+// compileRules prepares rules for evaluation, rules which could not be compiled are skipped
+// and reported in returned error, so that single typo does not disable all highlights
+func compileRules(settings []RuleSettings) ([]rule, error) {
+	var (
+		res  []rule
+		errs []string
+	)
+	for _, r := range settings {
+		program, err := expr.Compile(r.Expression, expr.Env(ruleEnv{}), expr.AsBool())
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("rule %q: %v", r.Name, err))
+			continue
+		}
+		res = append(res, rule{settings: r, program: program})
+	}
+	if len(errs) > 0 {
+		return res, fmt.Errorf("invalid rules: %s", strings.Join(errs, "; "))
+	}
+	return res, nil
+}
+
+func fillHighlights(now time.Time, rules []rule, projects []Project) []Project {
+	if len(rules) == 0 {
+		return projects
+	}
+	for i, project := range projects {
+		for j, mr := range project.MergeRequests {
+			env := newRuleEnv(now, mr)
+
+			var highlights []Highlight
+			for _, r := range rules {
+				matched, err := expr.Run(r.program, env)
+				if err != nil {
+					log.Printf("rule %q failed for merge request %s: %v", r.settings.Name, mr.URL, err)
+					continue
+				}
+				if matched == true {
+					highlights = append(highlights, Highlight{
+						Name:     r.settings.Name,
+						Color:    r.settings.Color,
+						Priority: r.settings.Priority,
+					})
+				}
+			}
+			sort.SliceStable(highlights, func(i, j int) bool {
+				return highlights[i].Priority > highlights[j].Priority
+			})
+			projects[i].MergeRequests[j].Highlights = highlights
+		}
+	}
+	return projects
+}
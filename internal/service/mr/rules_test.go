@@ -0,0 +1,162 @@
+package mr
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+func TestCompileRules(t *testing.T) {
+	tests := []struct {
+		name      string
+		settings  []RuleSettings
+		wantRules []string
+		wantErrs  []string
+	}{
+		{
+			name:      "no rules",
+			settings:  nil,
+			wantRules: nil,
+		},
+		{
+			name: "valid rules",
+			settings: []RuleSettings{
+				{Name: "urgent", Expression: `"urgent" in labels`},
+				{Name: "stale", Expression: `idle > duration("72h") && !draft`},
+				{Name: "big", Expression: `additions + deletions > 500 || files > 20`},
+			},
+			wantRules: []string{"urgent", "stale", "big"},
+		},
+		{
+			name: "invalid rules are skipped",
+			settings: []RuleSettings{
+				{Name: "unknown field", Expression: `reviewer == "bob"`},
+				{Name: "mine", Expression: `mine`},
+				{Name: "syntax", Expression: `title ==`},
+				{Name: "not boolean", Expression: `additions + deletions`},
+			},
+			wantRules: []string{"mine"},
+			wantErrs:  []string{`"unknown field"`, `"syntax"`, `"not boolean"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := compileRules(tt.settings)
+
+			names := lo.Map(rules, func(item rule, _ int) string { return item.settings.Name })
+			if !slices.Equal(names, tt.wantRules) {
+				t.Errorf("compileRules() rules = %v, want %v", names, tt.wantRules)
+			}
+
+			if len(tt.wantErrs) == 0 {
+				if err != nil {
+					t.Errorf("compileRules() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("compileRules() error is nil, want errors of %v", tt.wantErrs)
+			}
+			for _, want := range tt.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("compileRules() error %q does not mention rule %s", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestFillHighlights(t *testing.T) {
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+
+	rules, err := compileRules([]RuleSettings{
+		{Name: "urgent", Expression: `"urgent" in labels`, Color: "red", Priority: 10},
+		{Name: "stale", Expression: `idle > duration("72h")`, Color: "orange", Priority: 5},
+		{Name: "big", Expression: `additions + deletions > 500`, Color: "grey", Priority: 5},
+		{Name: "mine", Expression: `mine && approvalsLeft > 0`, Color: "blue", Priority: 1},
+		{Name: "first label", Expression: `labels[0] == "backend"`, Color: "green", Priority: 0},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mr := func(update func(mr *MergeRequest)) MergeRequest {
+		res := MergeRequest{
+			URL:       "https://gitlab.example.com/g/a/-/merge_requests/1",
+			CreatedAt: now.Add(-time.Hour),
+			UpdatedAt: now.Add(-time.Hour),
+		}
+		update(&res)
+		return res
+	}
+
+	tests := []struct {
+		name  string
+		rules []rule
+		mr    MergeRequest
+		want  []string
+	}{
+		{
+			name:  "no rules",
+			rules: nil,
+			mr: mr(func(mr *MergeRequest) {
+				mr.Labels = []Label{{Title: "urgent"}}
+			}),
+			want: nil,
+		},
+		{
+			name:  "nothing matched",
+			rules: rules,
+			mr: mr(func(mr *MergeRequest) {
+				mr.Labels = []Label{{Title: "docs"}}
+			}),
+			want: nil,
+		},
+		{
+			name:  "rule failed at runtime is skipped",
+			rules: rules,
+			mr: mr(func(mr *MergeRequest) {
+				mr.UpdatedAt = now.Add(-100 * time.Hour)
+			}),
+			want: []string{"stale"},
+		},
+		{
+			name:  "sorted by priority, rules order is kept for equal priorities",
+			rules: rules,
+			mr: mr(func(mr *MergeRequest) {
+				mr.Labels = []Label{{Title: "backend"}, {Title: "urgent"}}
+				mr.UpdatedAt = now.Add(-100 * time.Hour)
+				mr.DiffStatsSummary = DiffStatsSummary{Additions: 400, Deletions: 200}
+				mr.Author = User{Username: "me", IsMe: true}
+				mr.ApprovalRequirements.Left = 1
+			}),
+			want: []string{"urgent", "stale", "big", "mine", "first label"},
+		},
+		{
+			name:  "approvals left are taken into account",
+			rules: rules,
+			mr: mr(func(mr *MergeRequest) {
+				mr.Author = User{Username: "me", IsMe: true}
+				mr.ApprovalRequirements.Left = 0
+			}),
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projects := fillHighlights(now, tt.rules, []Project{{MergeRequests: []MergeRequest{tt.mr}}})
+
+			got := lo.Map(projects[0].MergeRequests[0].Highlights, func(item Highlight, _ int) string {
+				return item.Name
+			})
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("fillHighlights() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
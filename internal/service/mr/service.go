@@ -3,6 +3,7 @@ package mr
 import (
 	"context"
 	"fmt"
+	"log"
 	"regexp"
 	"sort"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/alitto/pond/v2"
 	"github.com/samber/lo"
 	"github.com/vlanse/glmr/internal/service/gitlab"
+	"github.com/vlanse/glmr/internal/service/store"
 )
 
 const (
@@ -28,93 +30,310 @@ var jiraIssueRx = regexp.MustCompile(`\[([A-Z]+-\d+)]`)
 type Service struct {
 	settings  Settings
 	gitlabSvc *gitlab.Service
+	storeSvc  *store.Service
 	pool      pond.Pool
 
 	currentUser *User
+	rules       []rule
 	dataMx      sync.Mutex
 
-	projectsByID map[int64]gitlab.Project
+	// lastSuccessByProject and projectPathsByID are accessed by refresh only, so they are guarded by refreshMx
+	lastSuccessByProject map[projectTrackingKey]time.Time
+	projectPathsByID     map[int64]string
+
+	changedFilesByMR map[mergeRequestKey]changedFiles
+	changedFilesMx   sync.Mutex
+
+	snapshot   *snapshot
+	snapshotMx sync.RWMutex
+	refreshMx  sync.Mutex
+	refreshCh  chan struct{}
+
+	subs subscribers
 }
 
-func NewService(gitlabSvc *gitlab.Service) *Service {
+func NewService(gitlabSvc *gitlab.Service, storeSvc *store.Service) *Service {
 	return &Service{
-		gitlabSvc:    gitlabSvc,
-		pool:         pond.NewPool(poolWorkerCount),
-		projectsByID: make(map[int64]gitlab.Project),
+		gitlabSvc:            gitlabSvc,
+		storeSvc:             storeSvc,
+		pool:                 pond.NewPool(poolWorkerCount),
+		lastSuccessByProject: make(map[projectTrackingKey]time.Time),
+		projectPathsByID:     make(map[int64]string),
+		changedFilesByMR:     make(map[mergeRequestKey]changedFiles),
+		refreshCh:            make(chan struct{}, 1),
 	}
 }
 
 func (s *Service) UpdateSettings(settings Settings) {
+	rules, err := compileRules(settings.Rules)
+	if err != nil {
+		log.Printf("highlight rules: %v", err)
+	}
+
 	s.dataMx.Lock()
 	defer s.dataMx.Unlock()
 	s.settings = settings
+	s.rules = rules
+	// gitlab URL or token may have changed, so the current user is requested again
+	s.currentUser = nil
+
+	s.triggerRefresh()
 }
 
-func (s *Service) GetMergeRequests(ctx context.Context, filter Filter) ([]MergeRequestsGroup, error) {
-	var currentUserName string
-	if err := func() error {
-		s.dataMx.Lock()
-		defer s.dataMx.Unlock()
-		if s.currentUser == nil {
-			user, err := s.gitlabSvc.GetCurrentUser(ctx)
-			if err != nil {
-				return fmt.Errorf("could not get current user information: %w", err)
-			}
-			s.currentUser = &User{
-				Username:  user.Username,
-				AvatarURL: user.AvatarURL,
-				WebURL:    user.WebURL,
-			}
-		}
-		currentUserName = s.currentUser.Username
-		return nil
-	}(); err != nil {
-		return nil, err
+func (s *Service) getSettings() Settings {
+	s.dataMx.Lock()
+	defer s.dataMx.Unlock()
+	return s.settings
+}
+
+func (s *Service) getRules() []rule {
+	s.dataMx.Lock()
+	defer s.dataMx.Unlock()
+	return s.rules
+}
+
+func (s *Service) GetMergeRequests(ctx context.Context, filter Filter, sortBy Sort, forceRefresh bool) (MergeRequestsResult, error) {
+	snap, err := s.getSnapshot(ctx, forceRefresh)
+	if err != nil {
+		return MergeRequestsResult{}, err
 	}
 
-	projects := s.settings.GetProjects()
+	now := time.Now()
 
-	var err error
+	groups := groupMergeRequests(snap.projects)
 
-	if projects, err = s.enrichProjectInfoGQ(ctx, projects); err != nil {
-		return nil, err
+	groups = s.fillLocalState(ctx, now, groups)
+
+	groups = fillGroupSummaries(groups)
+
+	groups = filterMergeRequests(groups, snap.currentUserName, filter, now)
+
+	groups = fillFilteredGroupSummaries(groups)
+
+	groups = sortMergeRequests(groups, sortBy)
+
+	return MergeRequestsResult{
+		Groups:    groups,
+		UpdatedAt: snap.updatedAt,
+	}, nil
+}
+
+// collect fetches merge requests of all configured projects from gitlab and enriches them
+func (s *Service) collect(ctx context.Context) ([]Project, string, error) {
+	currentUserName, err := s.getCurrentUserName(ctx)
+	if err != nil {
+		return nil, "", err
 	}
 
-	if projects, err = s.enrichProjectMRDiscussions(ctx, projects); err != nil {
-		return nil, err
+	startedAt := time.Now()
+	settings := s.getSettings()
+	projects := s.collectProjects(ctx, settings)
+
+	projects = s.enrichProjectInfoGQ(ctx, projects)
+
+	projects = append(projects, s.collectDynamicGroups(ctx, settings)...)
+
+	projects = s.enrichMergeRequests(ctx, projects)
+
+	projects = s.trackProjectErrors(settings, startedAt, projects)
+
+	projects = decorateMergeRequests(settings, s.getRules(), currentUserName, projects)
+
+	projects = s.suggestReviewers(ctx, settings.ReviewerSuggestions, projects, projects)
+
+	s.forgetChangedFiles(projects)
+
+	return projects, currentUserName, nil
+}
+
+func (s *Service) getCurrentUserName(ctx context.Context) (string, error) {
+	s.dataMx.Lock()
+	defer s.dataMx.Unlock()
+	if s.currentUser == nil {
+		user, err := s.gitlabSvc.GetCurrentUser(ctx)
+		if err != nil {
+			return "", fmt.Errorf("could not get current user information: %w", err)
+		}
+		s.currentUser = &User{
+			Username:  user.Username,
+			AvatarURL: user.AvatarURL,
+			WebURL:    user.WebURL,
+		}
 	}
+	return s.currentUser.Username, nil
+}
+
+// enrichMergeRequests fetches merge request details which did not come along with merge requests
+func (s *Service) enrichMergeRequests(ctx context.Context, projects []Project) []Project {
+	projects = s.enrichProjectMRDiscussions(ctx, projects)
+
+	projects = s.enrichMRApprovals(ctx, projects)
+
+	projects = s.enrichPipelineJobs(ctx, projects)
+
+	return projects
+}
 
-	projects = s.fillIssues(projects)
+// decorateMergeRequests computes merge request properties from already fetched data
+func decorateMergeRequests(settings Settings, rules []rule, currentUserName string, projects []Project) []Project {
+	now := time.Now()
+
+	projects = fillIssues(settings.JIRA, projects)
 
 	projects = fillOwners(projects)
 
 	projects = sortApprovers(currentUserName, projects)
 
-	projects = fillStatuses(projects)
+	projects = fillReviewMetrics(projects)
+
+	projects = fillStatuses(now, newCalendar(settings.Calendar), projects)
+
+	projects = fillReviewPriorities(now, currentUserName, projects)
 
 	projects = countDiscussions(projects)
 
 	projects = setApprovedBefore(currentUserName, projects)
 
-	groups := groupMergeRequests(projects)
+	projects = fillHighlights(now, rules, projects)
 
-	groups = fillGroupSummaries(groups)
+	return projects
+}
 
-	groups = filterMergeRequests(groups, currentUserName, filter)
+func (s *Service) isMe(username string) bool {
+	s.dataMx.Lock()
+	defer s.dataMx.Unlock()
+	return s.currentUser != nil && s.currentUser.Username == username
+}
 
-	groups = fillFilteredGroupSummaries(groups)
+// collectProjects returns configured projects along with projects discovered in configured namespaces;
+// namespace which could not be expanded is returned as a project with Error set
+func (s *Service) collectProjects(ctx context.Context, settings Settings) []Project {
+	type namespaceKey struct {
+		group, namespace int
+	}
+
+	var mx sync.Mutex
+	discovered := make(map[namespaceKey][]ProjectSettings)
+	discoverErrs := make(map[namespaceKey]error)
+
+	group := s.pool.NewGroup()
+	for i, g := range settings.Groups {
+		for j, namespace := range g.Namespaces {
+			group.Submit(func() {
+				projects, err := s.discoverProjects(ctx, namespace)
+				mx.Lock()
+				defer mx.Unlock()
+				if err != nil {
+					discoverErrs[namespaceKey{group: i, namespace: j}] = err
+					return
+				}
+				discovered[namespaceKey{group: i, namespace: j}] = projects
+			})
+		}
+	}
+	_ = group.Wait()
+
+	var projects []Project
+	for i, g := range settings.Groups {
+		groupSLA := settings.defaultSLA().overriddenBy(g.SLA)
+		groupProjectIDs := make(map[int64]struct{})
+		for _, project := range g.Projects {
+			groupProjectIDs[project.ID] = struct{}{}
+			projects = append(projects, Project{
+				ID:        project.ID,
+				Name:      project.Name,
+				GroupName: g.Name,
+				sla:       groupSLA.overriddenBy(project.SLA),
+			})
+		}
+
+		for j, namespace := range g.Namespaces {
+			if err, found := discoverErrs[namespaceKey{group: i, namespace: j}]; found {
+				projects = append(projects, Project{
+					Name:      namespace.Path,
+					GroupName: g.Name,
+					Error:     newProjectError(fmt.Errorf("discover projects of %s: %w", namespace.Path, err)),
+				})
+				continue
+			}
+
+			for _, project := range discovered[namespaceKey{group: i, namespace: j}] {
+				if _, found := groupProjectIDs[project.ID]; found {
+					continue
+				}
+				groupProjectIDs[project.ID] = struct{}{}
+				projects = append(projects, Project{
+					ID:        project.ID,
+					Name:      project.Name,
+					GroupName: g.Name,
+					sla:       groupSLA,
+				})
+			}
+		}
+	}
+	return projects
+}
+
+func (s *Service) discoverProjects(ctx context.Context, namespace NamespaceSettings) ([]ProjectSettings, error) {
+	projects, err := s.gitlabSvc.GetGroupProjects(ctx, namespace.Path, namespace.IncludeSubgroups, namespace.IncludeArchived)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.FilterMap(projects, func(item gitlab.Project, _ int) (ProjectSettings, bool) {
+		return ProjectSettings{
+			ID:   item.ID,
+			Name: item.Name,
+		}, namespace.Matches(item.Name, item.Path)
+	}), nil
+}
+
+// projectTrackingKey identifies project in error tracking, failed namespaces and dynamic groups
+// have no project ID, so they are identified by group and name
+type projectTrackingKey struct {
+	projectID int64
+	groupName string
+	name      string
+}
 
-	for _, g := range groups {
-		sort.SliceStable(g.MergeRequests, func(i, j int) bool {
-			return g.MergeRequests[i].CreatedAt.Before(g.MergeRequests[j].CreatedAt)
-		})
+func trackingKeyOf(p Project) projectTrackingKey {
+	if p.ID != 0 {
+		return projectTrackingKey{projectID: p.ID}
+	}
+	return projectTrackingKey{groupName: p.GroupName, name: p.Name}
+}
+
+// trackProjectErrors remembers when projects, namespaces and dynamic groups were fetched successfully last time
+func (s *Service) trackProjectErrors(settings Settings, fetchedAt time.Time, projects []Project) []Project {
+	failed := make(map[projectTrackingKey]bool)
+	for i, p := range projects {
+		key := trackingKeyOf(p)
+		if p.Error == nil {
+			s.lastSuccessByProject[key] = fetchedAt
+			continue
+		}
+		failed[key] = true
+		projects[i].Error.LastSuccessAt = s.lastSuccessByProject[key]
 	}
 
-	return groups, nil
+	// namespaces and dynamic groups are among projects only when they failed
+	for _, g := range settings.Groups {
+		for _, namespace := range g.Namespaces {
+			if key := (projectTrackingKey{groupName: g.Name, name: namespace.Path}); !failed[key] {
+				s.lastSuccessByProject[key] = fetchedAt
+			}
+		}
+	}
+	for _, g := range settings.DynamicGroups {
+		if key := (projectTrackingKey{groupName: g.Name, name: g.Name}); !failed[key] {
+			s.lastSuccessByProject[key] = fetchedAt
+		}
+	}
+	return projects
 }
 
-func (s *Service) fillIssues(projects []Project) []Project {
-	if len(s.settings.JIRA.URL) == 0 {
+func fillIssues(jira JIRA, projects []Project) []Project {
+	if len(jira.URL) == 0 {
 		return projects
 	}
 	for i, project := range projects {
@@ -132,7 +351,7 @@ func (s *Service) fillIssues(projects []Project) []Project {
 				keys = append(keys, key)
 				projects[i].MergeRequests[j].Issues = append(projects[i].MergeRequests[j].Issues, Issue{
 					Key: key,
-					URL: fmt.Sprintf("%s/browse/%s", s.settings.JIRA.URL, key),
+					URL: fmt.Sprintf("%s/browse/%s", jira.URL, key),
 				})
 			}
 		}
@@ -152,36 +371,6 @@ func fillGroupSummaries(groups []MergeRequestsGroup) []MergeRequestsGroup {
 	return groups
 }
 
-func filterMergeRequests(groups []MergeRequestsGroup, currentUsername string, filter Filter) []MergeRequestsGroup {
-	for i, group := range groups {
-		groups[i].MergeRequests = lo.Filter(group.MergeRequests, func(item MergeRequest, _ int) bool {
-			stillShowMine := filter.ButStillShowMine && item.Author.Username == currentUsername
-
-			if filter.DoNotShowDrafts && strings.HasPrefix(item.Description, "Draft:") {
-				if !stillShowMine {
-					return false
-				}
-			}
-
-			if filter.SkipApprovedByMe {
-				if lo.ContainsBy(item.Approvals, func(item Approval) bool {
-					return item.User.Username == currentUsername
-				}) {
-					if !stillShowMine {
-						return false
-					}
-				}
-			}
-
-			if filter.ShowOnlyMine && item.Author.Username != currentUsername {
-				return false
-			}
-			return true
-		})
-	}
-	return groups
-}
-
 func fillFilteredGroupSummaries(groups []MergeRequestsGroup) []MergeRequestsGroup {
 	for i, g := range groups {
 		for _, mr := range g.MergeRequests {
@@ -218,15 +407,18 @@ func fillOwners(projects []Project) []Project {
 	return projects
 }
 
-func fillStatuses(projects []Project) []Project {
+func fillStatuses(now time.Time, cal calendar, projects []Project) []Project {
 	for i, project := range projects {
 		for j, mr := range project.MergeRequests {
 			status := Status{
 				PipelineFailed: mr.Pipeline.Status == pipelineFailedStatus,
-				Outdated:       time.Since(mr.CreatedAt) > time.Hour*24*10,
 				Conflict:       mr.Status.Conflict,
+				SLAViolations:  checkSLA(now, cal, project.sla, mr),
 			}
-			status.Ready = mr.Pipeline.Status == pipelineSuccessStatus && !status.Conflict
+			status.Outdated = len(status.SLAViolations) > 0
+			// zero approvals left means nothing when approval requirements are unknown
+			status.Ready = mr.Pipeline.Status == pipelineSuccessStatus && !status.Conflict &&
+				mr.approvalsLoaded && mr.ApprovalRequirements.Left == 0
 
 			status.Pending = !lo.Contains(
 				[]string{pipelineSuccessStatus, pipelineFailedStatus}, mr.Pipeline.Status,
@@ -328,6 +520,9 @@ func groupMergeRequests(projects []Project) []MergeRequestsGroup {
 		}
 		for _, project := range ps {
 			mrg.MergeRequests = append(mrg.MergeRequests, project.MergeRequests...)
+			if project.Error != nil {
+				mrg.FailedProjects = append(mrg.FailedProjects, project)
+			}
 		}
 		res = append(res, mrg)
 	}
@@ -0,0 +1,76 @@
+package mr
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const defaultMaxAge = 10 * 24 * time.Hour
+
+// SLASettings limit how long merge request may wait, limits are measured in working time;
+// nil limit is inherited from the outer settings, zero disables the limit
+type SLASettings struct {
+	MaxAge             *time.Duration
+	MaxIdle            *time.Duration // time without any activity, i.e. new commits or comments
+	MaxFirstReviewWait *time.Duration // time without any comment or approval of somebody except author
+}
+
+// overriddenBy returns settings with limits set in other taking precedence, including zero ones
+func (s SLASettings) overriddenBy(other *SLASettings) SLASettings {
+	if other == nil {
+		return s
+	}
+	return SLASettings{
+		MaxAge:             lo.CoalesceOrEmpty(other.MaxAge, s.MaxAge),
+		MaxIdle:            lo.CoalesceOrEmpty(other.MaxIdle, s.MaxIdle),
+		MaxFirstReviewWait: lo.CoalesceOrEmpty(other.MaxFirstReviewWait, s.MaxFirstReviewWait),
+	}
+}
+
+type SLAViolationType string
+
+const (
+	SLAViolationMaxAge             SLAViolationType = "max_age"
+	SLAViolationMaxIdle            SLAViolationType = "max_idle"
+	SLAViolationMaxFirstReviewWait SLAViolationType = "max_first_review_wait"
+)
+
+type SLAViolation struct {
+	Type   SLAViolationType
+	Limit  time.Duration
+	Actual time.Duration // working time elapsed
+}
+
+func checkSLA(now time.Time, cal calendar, sla SLASettings, mr MergeRequest) []SLAViolation {
+	var res []SLAViolation
+	check := func(violationType SLAViolationType, limitPtr *time.Duration, since time.Time) {
+		limit := lo.FromPtr(limitPtr)
+		if limit <= 0 || since.IsZero() {
+			return
+		}
+		if actual := cal.workingTime(since, now); actual > limit {
+			res = append(res, SLAViolation{Type: violationType, Limit: limit, Actual: actual})
+		}
+	}
+
+	check(SLAViolationMaxAge, sla.MaxAge, mr.CreatedAt)
+	check(SLAViolationMaxIdle, sla.MaxIdle, mr.UpdatedAt)
+	if !hasReview(mr) {
+		check(SLAViolationMaxFirstReviewWait, sla.MaxFirstReviewWait, mr.CreatedAt)
+	}
+	return res
+}
+
+// hasReview reports whether merge request is approved or commented by somebody except author,
+// approval times and comment times may be unknown
+func hasReview(mr MergeRequest) bool {
+	if len(mr.Approvals) > 0 {
+		return true
+	}
+	return lo.SomeBy(mr.Discussions, func(d Discussion) bool {
+		return lo.SomeBy(d.Notes, func(n Note) bool {
+			return !n.System && n.Author.Username != mr.Author.Username
+		})
+	})
+}
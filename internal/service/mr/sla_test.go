@@ -0,0 +1,70 @@
+package mr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+func TestSLASettingsOverriddenBy(t *testing.T) {
+	group := SLASettings{
+		MaxAge:  lo.ToPtr(240 * time.Hour),
+		MaxIdle: lo.ToPtr(72 * time.Hour),
+	}
+
+	tests := []struct {
+		name  string
+		other *SLASettings
+		want  SLASettings
+	}{
+		{
+			name:  "no override",
+			other: nil,
+			want:  group,
+		},
+		{
+			name:  "omitted limits are inherited",
+			other: &SLASettings{MaxFirstReviewWait: lo.ToPtr(24 * time.Hour)},
+			want: SLASettings{
+				MaxAge:             lo.ToPtr(240 * time.Hour),
+				MaxIdle:            lo.ToPtr(72 * time.Hour),
+				MaxFirstReviewWait: lo.ToPtr(24 * time.Hour),
+			},
+		},
+		{
+			name:  "limit is replaced",
+			other: &SLASettings{MaxAge: lo.ToPtr(120 * time.Hour)},
+			want: SLASettings{
+				MaxAge:  lo.ToPtr(120 * time.Hour),
+				MaxIdle: lo.ToPtr(72 * time.Hour),
+			},
+		},
+		{
+			name:  "zero limit disables inherited one",
+			other: &SLASettings{MaxIdle: lo.ToPtr(time.Duration(0))},
+			want: SLASettings{
+				MaxAge:  lo.ToPtr(240 * time.Hour),
+				MaxIdle: lo.ToPtr(time.Duration(0)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := group.overriddenBy(tt.other)
+			for _, limit := range []struct {
+				name      string
+				got, want *time.Duration
+			}{
+				{name: "MaxAge", got: got.MaxAge, want: tt.want.MaxAge},
+				{name: "MaxIdle", got: got.MaxIdle, want: tt.want.MaxIdle},
+				{name: "MaxFirstReviewWait", got: got.MaxFirstReviewWait, want: tt.want.MaxFirstReviewWait},
+			} {
+				if (limit.got == nil) != (limit.want == nil) || lo.FromPtr(limit.got) != lo.FromPtr(limit.want) {
+					t.Errorf("%s = %v, want %v", limit.name, limit.got, limit.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package mr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+const (
+	defaultRefreshInterval = time.Minute
+
+	// forcedRefreshTimeout limits refresh requested by client, it is not canceled when client goes away
+	// since the result is shared with everyone
+	forcedRefreshTimeout = 5 * time.Minute
+)
+
+// snapshot keeps the latest enriched merge requests of all configured projects
+type snapshot struct {
+	projects        []Project
+	currentUserName string
+	updatedAt       time.Time
+}
+
+// Start runs background refresh of merge requests snapshot until ctx is done
+func (s *Service) Start(ctx context.Context) {
+	s.triggerRefresh()
+
+	go func() {
+		timer := time.NewTimer(s.getRefreshInterval())
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+			case <-s.refreshCh:
+			}
+
+			if _, err := s.refreshSnapshot(ctx, time.Now()); err != nil {
+				log.Printf("background refresh of merge requests failed: %v", err)
+			}
+
+			timer.Reset(s.getRefreshInterval())
+		}
+	}()
+}
+
+func (s *Service) triggerRefresh() {
+	select {
+	case s.refreshCh <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Service) getRefreshInterval() time.Duration {
+	if interval := s.getSettings().RefreshInterval; interval > 0 {
+		return interval
+	}
+	return defaultRefreshInterval
+}
+
+func (s *Service) getSnapshot(ctx context.Context, forceRefresh bool) (*snapshot, error) {
+	requestedAt := time.Now()
+	if !forceRefresh {
+		if snap := s.loadSnapshot(); snap != nil {
+			return snap, nil
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), forcedRefreshTimeout)
+	defer cancel()
+	return s.refreshSnapshot(ctx, requestedAt)
+}
+
+func (s *Service) loadSnapshot() *snapshot {
+	s.snapshotMx.RLock()
+	defer s.snapshotMx.RUnlock()
+	return s.snapshot
+}
+
+// refreshSnapshot collects fresh data from gitlab, only one refresh runs at a time;
+// when snapshot was refreshed by someone else after notBefore, it is reused as is
+func (s *Service) refreshSnapshot(ctx context.Context, notBefore time.Time) (*snapshot, error) {
+	s.refreshMx.Lock()
+	defer s.refreshMx.Unlock()
+
+	if snap := s.loadSnapshot(); snap != nil && !snap.updatedAt.Before(notBefore) {
+		return snap, nil
+	}
+
+	startedAt := time.Now()
+	projects, currentUserName, err := s.collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = ctx.Err(); err != nil {
+		// projects failed because refresh was interrupted, they should not replace the shared snapshot
+		return nil, fmt.Errorf("refresh interrupted: %w", err)
+	}
+
+	snap := &snapshot{
+		projects:        projects,
+		currentUserName: currentUserName,
+		updatedAt:       startedAt,
+	}
+
+	s.snapshotMx.Lock()
+	prev := s.snapshot
+	s.snapshot = snap
+	s.snapshotMx.Unlock()
+
+	events := s.diffSnapshots(ctx, prev, snap, snap.updatedAt)
+	s.recordHistory(ctx, snap, events, snap.updatedAt)
+	if prev == nil {
+		s.reconcileHistory(ctx, snap, snap.updatedAt)
+	}
+	s.publish(events)
+
+	return snap, nil
+}
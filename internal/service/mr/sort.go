@@ -0,0 +1,76 @@
+package mr
+
+import (
+	"sort"
+)
+
+// SortBy is an order of merge requests inside of group
+type SortBy int
+
+const (
+	SortByAge            SortBy = iota // the oldest first
+	SortByLastActivity                 // the most recently updated first
+	SortByDiffSize                     // the smallest first
+	SortByUnresolved                   // the most unresolved threads first
+	SortByApprovalsLeft                // the fewest approvals left first
+	SortByReviewPriority               // the highest review priority first
+)
+
+type Sort struct {
+	By      SortBy
+	Reverse bool
+}
+
+// sortMergeRequests sorts merge requests of each group, pinned merge requests go first regardless of order,
+// ties are ordered by age; Reverse turns over both order and ties
+func sortMergeRequests(groups []MergeRequestsGroup, s Sort) []MergeRequestsGroup {
+	less := lessFunc(s.By)
+	for _, g := range groups {
+		sort.SliceStable(g.MergeRequests, func(i, j int) bool {
+			a, b := g.MergeRequests[i], g.MergeRequests[j]
+			if a.Marks.Pinned != b.Marks.Pinned {
+				return a.Marks.Pinned
+			}
+			if s.Reverse {
+				a, b = b, a
+			}
+			if less(a, b) {
+				return true
+			}
+			if less(b, a) {
+				return false
+			}
+			return a.CreatedAt.Before(b.CreatedAt)
+		})
+	}
+	return groups
+}
+
+func lessFunc(by SortBy) func(a, b MergeRequest) bool {
+	switch by {
+	case SortByLastActivity:
+		return func(a, b MergeRequest) bool {
+			return a.UpdatedAt.After(b.UpdatedAt)
+		}
+	case SortByDiffSize:
+		return func(a, b MergeRequest) bool {
+			return diffSize(a) < diffSize(b)
+		}
+	case SortByUnresolved:
+		return func(a, b MergeRequest) bool {
+			return a.CommentStats.UnresolvedCount > b.CommentStats.UnresolvedCount
+		}
+	case SortByApprovalsLeft:
+		return func(a, b MergeRequest) bool {
+			return a.ApprovalRequirements.Left < b.ApprovalRequirements.Left
+		}
+	case SortByReviewPriority:
+		return func(a, b MergeRequest) bool {
+			return a.ReviewPriority > b.ReviewPriority
+		}
+	default:
+		return func(a, b MergeRequest) bool {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package mr
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+func TestLessFunc(t *testing.T) {
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		by   SortBy
+		a, b MergeRequest
+	}{
+		{
+			name: "age, the oldest first",
+			by:   SortByAge,
+			a:    MergeRequest{CreatedAt: now.Add(-48 * time.Hour)},
+			b:    MergeRequest{CreatedAt: now.Add(-time.Hour)},
+		},
+		{
+			name: "last activity, the most recent first",
+			by:   SortByLastActivity,
+			a:    MergeRequest{UpdatedAt: now.Add(-time.Minute)},
+			b:    MergeRequest{UpdatedAt: now.Add(-time.Hour)},
+		},
+		{
+			name: "diff size, the smallest first",
+			by:   SortByDiffSize,
+			a:    MergeRequest{DiffStatsSummary: DiffStatsSummary{Additions: 10, Deletions: 10}},
+			b:    MergeRequest{DiffStatsSummary: DiffStatsSummary{Additions: 5, Deletions: 50}},
+		},
+		{
+			name: "unresolved, the most first",
+			by:   SortByUnresolved,
+			a:    MergeRequest{CommentStats: CommentStats{UnresolvedCount: 3}},
+			b:    MergeRequest{CommentStats: CommentStats{UnresolvedCount: 1}},
+		},
+		{
+			name: "approvals left, the fewest first",
+			by:   SortByApprovalsLeft,
+			a:    MergeRequest{ApprovalRequirements: ApprovalRequirements{Left: 0}},
+			b:    MergeRequest{ApprovalRequirements: ApprovalRequirements{Left: 2}},
+		},
+		{
+			name: "review priority, the highest first",
+			by:   SortByReviewPriority,
+			a:    MergeRequest{ReviewPriority: 80},
+			b:    MergeRequest{ReviewPriority: 20.5},
+		},
+		{
+			name: "unknown order falls back to age",
+			by:   SortBy(100),
+			a:    MergeRequest{CreatedAt: now.Add(-48 * time.Hour)},
+			b:    MergeRequest{CreatedAt: now.Add(-time.Hour)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			less := lessFunc(tt.by)
+			if !less(tt.a, tt.b) {
+				t.Errorf("less(a, b) = false, want true")
+			}
+			if less(tt.b, tt.a) {
+				t.Errorf("less(b, a) = true, want false")
+			}
+			if less(tt.a, tt.a) {
+				t.Errorf("less(a, a) = true, want false")
+			}
+		})
+	}
+}
+
+func TestSortMergeRequests(t *testing.T) {
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+
+	mr := func(iid int64, age time.Duration, additions int64, pinned bool) MergeRequest {
+		return MergeRequest{
+			IID:              iid,
+			CreatedAt:        now.Add(-age),
+			DiffStatsSummary: DiffStatsSummary{Additions: additions},
+			Marks:            Marks{Pinned: pinned},
+		}
+	}
+	// IID tells age rank, the lower the older
+	mrs := []MergeRequest{
+		mr(3, 3*time.Hour, 100, false),
+		mr(1, 5*time.Hour, 10, false),
+		mr(5, time.Hour, 10, true),
+		mr(2, 4*time.Hour, 100, true),
+		mr(4, 2*time.Hour, 10, false),
+	}
+
+	tests := []struct {
+		name string
+		sort Sort
+		want []int64
+	}{
+		{
+			name: "by age, pinned first",
+			sort: Sort{By: SortByAge},
+			want: []int64{2, 5, 1, 3, 4},
+		},
+		{
+			name: "by age reversed, pinned still first",
+			sort: Sort{By: SortByAge, Reverse: true},
+			want: []int64{5, 2, 4, 3, 1},
+		},
+		{
+			name: "by diff size, ties by age",
+			sort: Sort{By: SortByDiffSize},
+			want: []int64{5, 2, 1, 4, 3},
+		},
+		{
+			name: "by diff size reversed, ties reversed too",
+			sort: Sort{By: SortByDiffSize, Reverse: true},
+			want: []int64{2, 5, 3, 4, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups := sortMergeRequests(
+				[]MergeRequestsGroup{{MergeRequests: slices.Clone(mrs)}, {}}, tt.sort,
+			)
+
+			got := lo.Map(groups[0].MergeRequests, func(item MergeRequest, _ int) int64 {
+				return item.IID
+			})
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("sortMergeRequests() = %v, want %v", got, tt.want)
+			}
+			if len(groups[1].MergeRequests) != 0 {
+				t.Errorf("sortMergeRequests() empty group = %v, want empty", groups[1].MergeRequests)
+			}
+		})
+	}
+}
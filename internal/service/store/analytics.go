@@ -0,0 +1,111 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// GetAnalyticsRecords returns merge requests which were opened before to and were not merged or closed before from
+func (s *Service) GetAnalyticsRecords(ctx context.Context, from, to time.Time) ([]AnalyticsRecord, error) {
+	rows, err := s.db.QueryContext(ctx, `
+SELECT m.project_id, m.iid, m.project_name, m.url, m.author, m.created_at,
+       COALESCE(NULLIF(m.merged_at, 0), (SELECT MIN(recorded_at) FROM merge_request_states
+        WHERE project_id = m.project_id AND iid = m.iid AND state = 'merged')),
+       l.additions, l.deletions
+FROM merge_requests AS m
+JOIN merge_request_states AS l ON l.project_id = m.project_id AND l.iid = m.iid AND l.recorded_at = (
+    SELECT MAX(recorded_at) FROM merge_request_states WHERE project_id = m.project_id AND iid = m.iid
+)
+WHERE m.created_at < ?1 AND (l.state = 'opened' OR l.recorded_at >= ?2)`,
+		to.UnixMilli(), from.UnixMilli(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not get analytics records: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var res []AnalyticsRecord
+	idxByKey := make(map[MergeRequestKey]int)
+	for rows.Next() {
+		var (
+			r         AnalyticsRecord
+			createdAt int64
+			mergedAt  *int64
+		)
+		if err = rows.Scan(
+			&r.MergeRequest.ProjectID, &r.MergeRequest.IID, &r.MergeRequest.ProjectName, &r.MergeRequest.URL,
+			&r.MergeRequest.Author, &createdAt, &mergedAt, &r.Additions, &r.Deletions,
+		); err != nil {
+			return nil, fmt.Errorf("could not get analytics records: %w", err)
+		}
+		r.MergeRequest.CreatedAt = time.UnixMilli(createdAt)
+		if mergedAt != nil {
+			r.MergedAt = time.UnixMilli(*mergedAt)
+		}
+		idxByKey[r.MergeRequest.MergeRequestKey] = len(res)
+		res = append(res, r)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not get analytics records: %w", err)
+	}
+
+	// reviews which happened before the window are needed too, to find out the first review of merge request
+	reviewRows, err := s.db.QueryContext(ctx, `
+SELECT project_id, iid, username, reviewed_at FROM merge_request_reviews WHERE reviewed_at < ?`, to.UnixMilli())
+	if err != nil {
+		return nil, fmt.Errorf("could not get analytics records: %w", err)
+	}
+	defer func() { _ = reviewRows.Close() }()
+
+	for reviewRows.Next() {
+		var (
+			key        MergeRequestKey
+			review     Review
+			reviewedAt int64
+		)
+		if err = reviewRows.Scan(&key.ProjectID, &key.IID, &review.Username, &reviewedAt); err != nil {
+			return nil, fmt.Errorf("could not get analytics records: %w", err)
+		}
+		if idx, found := idxByKey[key]; found {
+			review.ReviewedAt = time.UnixMilli(reviewedAt)
+			res[idx].Reviews = append(res[idx].Reviews, review)
+		}
+	}
+	if err = reviewRows.Err(); err != nil {
+		return nil, fmt.Errorf("could not get analytics records: %w", err)
+	}
+	return res, nil
+}
+
+// GetReviewCounts returns numbers of merge requests reviewed by users since given time, keyed by project and username
+func (s *Service) GetReviewCounts(ctx context.Context, since time.Time) (map[int64]map[string]int, error) {
+	rows, err := s.db.QueryContext(ctx, `
+SELECT project_id, username, COUNT(*) FROM merge_request_reviews WHERE reviewed_at >= ? GROUP BY project_id, username`,
+		since.UnixMilli(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not get review counts: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := make(map[int64]map[string]int)
+	for rows.Next() {
+		var (
+			projectID int64
+			username  string
+			count     int
+		)
+		if err = rows.Scan(&projectID, &username, &count); err != nil {
+			return nil, fmt.Errorf("could not get review counts: %w", err)
+		}
+		if res[projectID] == nil {
+			res[projectID] = make(map[string]int)
+		}
+		res[projectID][username] = count
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not get review counts: %w", err)
+	}
+	return res, nil
+}
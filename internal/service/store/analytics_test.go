@@ -0,0 +1,168 @@
+package store
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+func TestGetAnalyticsRecords(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	from, to := testStart.Add(10*time.Hour), testStart.Add(20*time.Hour)
+
+	const (
+		openedInWindow = iota + 1
+		createdAfterWindow
+		stillOpened
+		mergedBeforeWindow
+		mergedInWindow
+		mergedInWindowUnknownTime
+		closedInWindow
+	)
+	created := func(r Record, hours int) Record {
+		r.MergeRequest.CreatedAt = testStart.Add(time.Duration(hours) * time.Hour)
+		return r
+	}
+	merged := func(r Record, hours int) Record {
+		r.MergeRequest.MergedAt = testStart.Add(time.Duration(hours) * time.Hour)
+		return r
+	}
+	sized := func(r Record, additions, deletions int64) Record {
+		r.State.Additions, r.State.Deletions = additions, deletions
+		return r
+	}
+
+	for _, batch := range [][]Record{
+		{
+			testRecord(stillOpened, 0, openedState, "a", testReview("bob", 1), testReview("carol", 21)),
+			testRecord(mergedBeforeWindow, 0, openedState, "a"),
+			testRecord(mergedInWindow, 0, openedState, "a"),
+			testRecord(mergedInWindowUnknownTime, 0, openedState, "a"),
+			testRecord(closedInWindow, 0, openedState, "a"),
+		},
+		{
+			sized(testRecord(stillOpened, 5, openedState, "b"), 10, 5),
+			testRecord(mergedBeforeWindow, 5, "merged", "a"),
+		},
+		{
+			created(testRecord(openedInWindow, 12, openedState, "a", testReview("bob", 13)), 12),
+			merged(testRecord(mergedInWindow, 15, "merged", "a"), 14),
+			testRecord(mergedInWindowUnknownTime, 16, "merged", "a"),
+			testRecord(closedInWindow, 18, "closed", "a"),
+		},
+		{
+			created(testRecord(createdAfterWindow, 25, openedState, "a"), 25),
+		},
+	} {
+		if err := s.RecordStates(ctx, batch); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	records, err := s.GetAnalyticsRecords(ctx, from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := lo.SliceToMap(records, func(item AnalyticsRecord) (int64, AnalyticsRecord) {
+		return item.MergeRequest.IID, item
+	})
+	if want := []int64{openedInWindow, stillOpened, mergedInWindow, mergedInWindowUnknownTime, closedInWindow}; !slices.Equal(
+		slices.Sorted(maps.Keys(got)), slices.Sorted(slices.Values(want)),
+	) {
+		t.Fatalf("GetAnalyticsRecords() merge requests = %v, want %v", slices.Sorted(maps.Keys(got)), want)
+	}
+
+	tests := []struct {
+		name          string
+		iid           int64
+		wantCreatedAt time.Time
+		wantMergedAt  time.Time
+		wantSize      [2]int64
+		wantReviews   []Review
+	}{
+		{
+			name:          "opened within window",
+			iid:           openedInWindow,
+			wantCreatedAt: testStart.Add(12 * time.Hour),
+			wantReviews:   []Review{testReview("bob", 13)},
+		},
+		{
+			name:          "opened before window, reviews after window are skipped",
+			iid:           stillOpened,
+			wantCreatedAt: testStart,
+			wantSize:      [2]int64{10, 5},
+			wantReviews:   []Review{testReview("bob", 1)},
+		},
+		{
+			name:          "merge time reported by gitlab",
+			iid:           mergedInWindow,
+			wantCreatedAt: testStart,
+			wantMergedAt:  testStart.Add(14 * time.Hour),
+		},
+		{
+			name:          "merge time is when merge was recorded",
+			iid:           mergedInWindowUnknownTime,
+			wantCreatedAt: testStart,
+			wantMergedAt:  testStart.Add(16 * time.Hour),
+		},
+		{
+			name:          "closed within window",
+			iid:           closedInWindow,
+			wantCreatedAt: testStart,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := got[tt.iid]
+			if !r.MergeRequest.CreatedAt.Equal(tt.wantCreatedAt) {
+				t.Errorf("created at %v, want %v", r.MergeRequest.CreatedAt, tt.wantCreatedAt)
+			}
+			if !r.MergedAt.Equal(tt.wantMergedAt) {
+				t.Errorf("merged at %v, want %v", r.MergedAt, tt.wantMergedAt)
+			}
+			if size := [2]int64{r.Additions, r.Deletions}; size != tt.wantSize {
+				t.Errorf("size = %v, want %v", size, tt.wantSize)
+			}
+			if !slices.EqualFunc(r.Reviews, tt.wantReviews, func(a, b Review) bool {
+				return a.Username == b.Username && a.ReviewedAt.Equal(b.ReviewedAt)
+			}) {
+				t.Errorf("reviews = %v, want %v", r.Reviews, tt.wantReviews)
+			}
+		})
+	}
+}
+
+func TestGetReviewCounts(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+
+	project := func(r Record, projectID int64) Record {
+		r.MergeRequest.ProjectID = projectID
+		return r
+	}
+	if err := s.RecordStates(ctx, []Record{
+		testRecord(1, 0, openedState, "a", testReview("bob", 1), testReview("carol", 5)),
+		testRecord(2, 0, openedState, "a", testReview("bob", 6)),
+		project(testRecord(1, 0, openedState, "a", testReview("bob", 7)), 2),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := s.GetReviewCounts(ctx, testStart.Add(5*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[int64]map[string]int{
+		1: {"bob": 1, "carol": 1},
+		2: {"bob": 1},
+	}
+	if !maps.EqualFunc(got, want, maps.Equal) {
+		t.Errorf("GetReviewCounts() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,110 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// GetAnnotations returns annotations of all merge requests which have them
+func (s *Service) GetAnnotations(ctx context.Context) (map[MergeRequestKey]Annotation, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT project_id, iid, note, tags, updated_at FROM merge_request_annotations`)
+	if err != nil {
+		return nil, fmt.Errorf("could not get merge request annotations: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := make(map[MergeRequestKey]Annotation)
+	for rows.Next() {
+		var key MergeRequestKey
+		a, err := scanAnnotation(rows, &key.ProjectID, &key.IID)
+		if err != nil {
+			return nil, fmt.Errorf("could not get merge request annotations: %w", err)
+		}
+		res[key] = a
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not get merge request annotations: %w", err)
+	}
+	return res, nil
+}
+
+// UpdateAnnotation applies update to the current annotation of merge request and saves the result,
+// annotation becoming empty is deleted
+func (s *Service) UpdateAnnotation(
+	ctx context.Context, key MergeRequestKey, now time.Time, update func(Annotation) Annotation,
+) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not update merge request annotation: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rows, err := tx.QueryContext(ctx, `
+SELECT note, tags, updated_at FROM merge_request_annotations WHERE project_id = ? AND iid = ?`,
+		key.ProjectID, key.IID,
+	)
+	if err != nil {
+		return fmt.Errorf("could not update merge request annotation: %w", err)
+	}
+	var a Annotation
+	if rows.Next() {
+		a, err = scanAnnotation(rows)
+	}
+	if err == nil {
+		err = rows.Err()
+	}
+	_ = rows.Close()
+	if err != nil {
+		return fmt.Errorf("could not update merge request annotation: %w", err)
+	}
+
+	a = update(a)
+	a.UpdatedAt = now
+	if a.empty() {
+		_, err = tx.ExecContext(ctx, `DELETE FROM merge_request_annotations WHERE project_id = ? AND iid = ?`,
+			key.ProjectID, key.IID,
+		)
+	} else {
+		err = upsertAnnotation(ctx, tx, key, a)
+	}
+	if err != nil {
+		return fmt.Errorf("could not update merge request annotation: %w", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not update merge request annotation: %w", err)
+	}
+	return nil
+}
+
+func upsertAnnotation(ctx context.Context, tx *sql.Tx, key MergeRequestKey, a Annotation) error {
+	tags, err := json.Marshal(a.Tags)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `
+INSERT OR REPLACE INTO merge_request_annotations (project_id, iid, note, tags, updated_at)
+VALUES (?, ?, ?, ?, ?)`,
+		key.ProjectID, key.IID, a.Note, string(tags), a.UpdatedAt.UnixMilli(),
+	)
+	return err
+}
+
+// scanAnnotation reads annotation columns preceded by extra columns
+func scanAnnotation(rows *sql.Rows, extra ...any) (Annotation, error) {
+	var (
+		a         Annotation
+		tags      string
+		updatedAt int64
+	)
+	if err := rows.Scan(append(extra, &a.Note, &tags, &updatedAt)...); err != nil {
+		return Annotation{}, err
+	}
+	a.UpdatedAt = time.UnixMilli(updatedAt)
+	if err := json.Unmarshal([]byte(tags), &a.Tags); err != nil {
+		return Annotation{}, fmt.Errorf("invalid tags: %w", err)
+	}
+	return a, nil
+}
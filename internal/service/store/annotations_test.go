@@ -0,0 +1,83 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestUpdateAnnotation(t *testing.T) {
+	key := MergeRequestKey{ProjectID: 1, IID: 1}
+	setNote := func(note string) func(Annotation) Annotation {
+		return func(a Annotation) Annotation { a.Note = note; return a }
+	}
+	setTags := func(tags ...string) func(Annotation) Annotation {
+		return func(a Annotation) Annotation { a.Tags = tags; return a }
+	}
+
+	tests := []struct {
+		name    string
+		updates []func(Annotation) Annotation
+		want    *Annotation
+	}{
+		{
+			name:    "note is added",
+			updates: []func(Annotation) Annotation{setNote("**check** later")},
+			want:    &Annotation{Note: "**check** later"},
+		},
+		{
+			name:    "update keeps the rest of annotation",
+			updates: []func(Annotation) Annotation{setNote("note"), setTags("a", "b")},
+			want:    &Annotation{Note: "note", Tags: []string{"a", "b"}},
+		},
+		{
+			name:    "empty note is deleted, tags are kept",
+			updates: []func(Annotation) Annotation{setNote("note"), setTags("a"), setNote("")},
+			want:    &Annotation{Tags: []string{"a"}},
+		},
+		{
+			name:    "empty annotation is deleted",
+			updates: []func(Annotation) Annotation{setNote("note"), setTags("a"), setNote(""), setTags()},
+			want:    nil,
+		},
+		{
+			name:    "empty annotation is not created",
+			updates: []func(Annotation) Annotation{setNote("")},
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestService(t)
+
+			var updatedAt time.Time
+			for i, update := range tt.updates {
+				updatedAt = testStart.Add(time.Duration(i) * time.Hour)
+				if err := s.UpdateAnnotation(ctx, key, updatedAt, update); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			annotations, err := s.GetAnnotations(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, found := annotations[key]
+			if found != (tt.want != nil) {
+				t.Fatalf("GetAnnotations() = %+v, want %+v", annotations, tt.want)
+			}
+			if !found {
+				return
+			}
+			if got.Note != tt.want.Note || !slices.Equal(got.Tags, tt.want.Tags) {
+				t.Errorf("annotation = %+v, want %+v", got, *tt.want)
+			}
+			if !got.UpdatedAt.Equal(updatedAt) {
+				t.Errorf("annotation updated at %v, want %v", got.UpdatedAt, updatedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,343 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const (
+	openedState = "opened"
+
+	stateColumns = `recorded_at, state, title, draft, conflict, pipeline_status, approved_by, approvals_left,
+       threads, unresolved_threads, additions, deletions`
+)
+
+// RecordStates saves states and reviews of merge requests which changed since the last recorded ones
+func (s *Service) RecordStates(ctx context.Context, records []Record) error {
+	s.historyMx.Lock()
+	defer s.historyMx.Unlock()
+
+	type change struct {
+		record       Record
+		stateChanged bool
+		newReviews   []Review
+	}
+
+	var changes []change
+	for _, r := range records {
+		key := r.MergeRequest.MergeRequestKey
+		latest, found := s.latestStates[key]
+		c := change{
+			record:       r,
+			stateChanged: !found || !latest.sameAs(r.State),
+			newReviews: lo.Filter(r.Reviews, func(item Review, _ int) bool {
+				known, found := s.knownReviews[key][item.Username]
+				return !found || item.ReviewedAt.Before(known)
+			}),
+		}
+		if c.stateChanged || len(c.newReviews) > 0 {
+			changes = append(changes, c)
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not record merge request states: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, c := range changes {
+		if err = upsertMergeRequest(ctx, tx, c.record.MergeRequest); err != nil {
+			return fmt.Errorf("could not record merge request %s: %w", c.record.MergeRequest.URL, err)
+		}
+		if c.stateChanged {
+			if err = insertState(ctx, tx, c.record.MergeRequest.MergeRequestKey, c.record.State); err != nil {
+				return fmt.Errorf("could not record state of merge request %s: %w", c.record.MergeRequest.URL, err)
+			}
+		}
+		for _, review := range c.newReviews {
+			if err = upsertReview(ctx, tx, c.record.MergeRequest.MergeRequestKey, review); err != nil {
+				return fmt.Errorf("could not record review of merge request %s: %w", c.record.MergeRequest.URL, err)
+			}
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not record merge request states: %w", err)
+	}
+
+	for _, c := range changes {
+		key := c.record.MergeRequest.MergeRequestKey
+		if c.stateChanged {
+			s.latestStates[key] = c.record.State
+		}
+		for _, review := range c.newReviews {
+			if s.knownReviews[key] == nil {
+				s.knownReviews[key] = make(map[string]time.Time)
+			}
+			s.knownReviews[key][review.Username] = review.ReviewedAt
+		}
+	}
+	return nil
+}
+
+// OpenedMergeRequests returns merge requests which are opened according to the latest recorded states
+func (s *Service) OpenedMergeRequests() []MergeRequestKey {
+	s.historyMx.Lock()
+	defer s.historyMx.Unlock()
+
+	var res []MergeRequestKey
+	for key, st := range s.latestStates {
+		if st.State == openedState {
+			res = append(res, key)
+		}
+	}
+	return res
+}
+
+// RecordFinalStates records that opened merge requests were merged or closed at now, the rest of their state
+// is copied from the latest recorded one
+func (s *Service) RecordFinalStates(ctx context.Context, now time.Time, states map[MergeRequestKey]FinalState) error {
+	s.historyMx.Lock()
+	defer s.historyMx.Unlock()
+
+	changed := make(map[MergeRequestKey]MergeRequestState)
+	for key, state := range states {
+		latest, found := s.latestStates[key]
+		if !found || latest.State != openedState {
+			continue
+		}
+		latest.State = state.State
+		latest.RecordedAt = now
+		changed[key] = latest
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not record merge request states: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for key, st := range changed {
+		if err = insertState(ctx, tx, key, st); err != nil {
+			return fmt.Errorf("could not record state of merge request %d!%d: %w", key.ProjectID, key.IID, err)
+		}
+		if mergedAt := states[key].MergedAt; !mergedAt.IsZero() {
+			if _, err = tx.ExecContext(ctx, `UPDATE merge_requests SET merged_at = ? WHERE project_id = ? AND iid = ?`,
+				mergedAt.UnixMilli(), key.ProjectID, key.IID,
+			); err != nil {
+				return fmt.Errorf("could not record merge time of merge request %d!%d: %w", key.ProjectID, key.IID, err)
+			}
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not record merge request states: %w", err)
+	}
+
+	for key, st := range changed {
+		s.latestStates[key] = st
+	}
+	return nil
+}
+
+func upsertMergeRequest(ctx context.Context, tx *sql.Tx, mr MergeRequest) error {
+	_, err := tx.ExecContext(ctx, `
+INSERT INTO merge_requests (project_id, iid, project_name, url, author, created_at, merged_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (project_id, iid) DO UPDATE SET project_name = excluded.project_name, url = excluded.url,
+    merged_at = MAX(merged_at, excluded.merged_at)`,
+		mr.ProjectID, mr.IID, mr.ProjectName, mr.URL, mr.Author, mr.CreatedAt.UnixMilli(), toUnixMilli(mr.MergedAt),
+	)
+	return err
+}
+
+func insertState(ctx context.Context, tx *sql.Tx, key MergeRequestKey, st MergeRequestState) error {
+	approvedBy, err := json.Marshal(st.ApprovedBy)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `
+INSERT OR REPLACE INTO merge_request_states (project_id, iid, `+stateColumns+`)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		key.ProjectID, key.IID, st.RecordedAt.UnixMilli(), st.State, st.Title, st.Draft, st.Conflict, st.PipelineStatus,
+		string(approvedBy), st.ApprovalsLeft, st.Threads, st.UnresolvedThreads, st.Additions, st.Deletions,
+	)
+	return err
+}
+
+func upsertReview(ctx context.Context, tx *sql.Tx, key MergeRequestKey, review Review) error {
+	_, err := tx.ExecContext(ctx, `
+INSERT INTO merge_request_reviews (project_id, iid, username, reviewed_at)
+VALUES (?, ?, ?, ?)
+ON CONFLICT (project_id, iid, username) DO UPDATE SET reviewed_at = MIN(reviewed_at, excluded.reviewed_at)`,
+		key.ProjectID, key.IID, review.Username, review.ReviewedAt.UnixMilli(),
+	)
+	return err
+}
+
+// GetTimeline returns recorded states of merge request, the oldest first;
+// merge request is nil when it has no recorded history
+func (s *Service) GetTimeline(ctx context.Context, key MergeRequestKey) (*MergeRequest, []MergeRequestState, error) {
+	mr := MergeRequest{MergeRequestKey: key}
+	var createdAt int64
+	err := s.db.QueryRowContext(ctx, `
+SELECT project_name, url, author, created_at FROM merge_requests WHERE project_id = ? AND iid = ?`,
+		key.ProjectID, key.IID,
+	).Scan(&mr.ProjectName, &mr.URL, &mr.Author, &createdAt)
+	if err == sql.ErrNoRows {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get merge request history: %w", err)
+	}
+	mr.CreatedAt = time.UnixMilli(createdAt)
+
+	rows, err := s.db.QueryContext(ctx, `
+SELECT `+stateColumns+` FROM merge_request_states WHERE project_id = ? AND iid = ? ORDER BY recorded_at`,
+		key.ProjectID, key.IID,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get merge request history: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var states []MergeRequestState
+	for rows.Next() {
+		st, err := scanState(rows)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not get merge request history: %w", err)
+		}
+		states = append(states, st)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("could not get merge request history: %w", err)
+	}
+	return &mr, states, nil
+}
+
+func (s *Service) loadLatestStates(ctx context.Context) (map[MergeRequestKey]MergeRequestState, error) {
+	rows, err := s.db.QueryContext(ctx, `
+SELECT project_id, iid, `+stateColumns+` FROM merge_request_states AS s
+WHERE recorded_at = (SELECT MAX(recorded_at) FROM merge_request_states WHERE project_id = s.project_id AND iid = s.iid)`)
+	if err != nil {
+		return nil, fmt.Errorf("could not load latest merge request states: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := make(map[MergeRequestKey]MergeRequestState)
+	for rows.Next() {
+		var key MergeRequestKey
+		st, err := scanState(rows, &key.ProjectID, &key.IID)
+		if err != nil {
+			return nil, fmt.Errorf("could not load latest merge request states: %w", err)
+		}
+		res[key] = st
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not load latest merge request states: %w", err)
+	}
+	return res, nil
+}
+
+// scanState reads state columns preceded by extra columns
+func scanState(rows *sql.Rows, extra ...any) (MergeRequestState, error) {
+	var (
+		st         MergeRequestState
+		recordedAt int64
+		approvedBy string
+	)
+	dest := append(extra,
+		&recordedAt, &st.State, &st.Title, &st.Draft, &st.Conflict, &st.PipelineStatus, &approvedBy, &st.ApprovalsLeft,
+		&st.Threads, &st.UnresolvedThreads, &st.Additions, &st.Deletions,
+	)
+	if err := rows.Scan(dest...); err != nil {
+		return MergeRequestState{}, err
+	}
+	st.RecordedAt = time.UnixMilli(recordedAt)
+	if err := json.Unmarshal([]byte(approvedBy), &st.ApprovedBy); err != nil {
+		return MergeRequestState{}, fmt.Errorf("invalid approvals: %w", err)
+	}
+	return st, nil
+}
+
+// deleteHistoryBefore deletes states recorded before t, the latest state of opened merge request is kept,
+// so it is not recorded again as a change; reviews are deleted along with merge request, marks do not depend
+// on history and are cleaned up by deleteStaleMarks
+func (s *Service) deleteHistoryBefore(ctx context.Context, t time.Time) error {
+	s.historyMx.Lock()
+	defer s.historyMx.Unlock()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err = tx.ExecContext(ctx, `
+DELETE FROM merge_request_states AS s
+WHERE recorded_at < ?1 AND (
+    state != 'opened' OR
+    recorded_at < (SELECT MAX(recorded_at) FROM merge_request_states WHERE project_id = s.project_id AND iid = s.iid)
+)`, t.UnixMilli()); err != nil {
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, `
+DELETE FROM merge_requests AS m
+WHERE NOT EXISTS (SELECT 1 FROM merge_request_states WHERE project_id = m.project_id AND iid = m.iid)`); err != nil {
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, `
+DELETE FROM merge_request_reviews AS r
+WHERE NOT EXISTS (SELECT 1 FROM merge_requests WHERE project_id = r.project_id AND iid = r.iid)`); err != nil {
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	for key, st := range s.latestStates {
+		if st.RecordedAt.Before(t) && st.State != openedState {
+			delete(s.latestStates, key)
+			delete(s.knownReviews, key)
+		}
+	}
+	return nil
+}
+
+func (s *Service) loadReviews(ctx context.Context) (map[MergeRequestKey]map[string]time.Time, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT project_id, iid, username, reviewed_at FROM merge_request_reviews`)
+	if err != nil {
+		return nil, fmt.Errorf("could not load merge request reviews: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := make(map[MergeRequestKey]map[string]time.Time)
+	for rows.Next() {
+		var (
+			key        MergeRequestKey
+			username   string
+			reviewedAt int64
+		)
+		if err = rows.Scan(&key.ProjectID, &key.IID, &username, &reviewedAt); err != nil {
+			return nil, fmt.Errorf("could not load merge request reviews: %w", err)
+		}
+		if res[key] == nil {
+			res[key] = make(map[string]time.Time)
+		}
+		res[key][username] = time.UnixMilli(reviewedAt)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not load merge request reviews: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,327 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+var testStart = time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+
+func testRecord(iid int64, hours int, state, title string, reviews ...Review) Record {
+	return Record{
+		MergeRequest: MergeRequest{
+			MergeRequestKey: MergeRequestKey{ProjectID: 1, IID: iid},
+			ProjectName:     "a",
+			URL:             "https://gitlab.example.com/g/a/-/merge_requests/1",
+			Author:          "alice",
+			CreatedAt:       testStart,
+		},
+		State: MergeRequestState{
+			RecordedAt:     testStart.Add(time.Duration(hours) * time.Hour),
+			State:          state,
+			Title:          title,
+			PipelineStatus: "running",
+			ApprovedBy:     []string{},
+		},
+		Reviews: reviews,
+	}
+}
+
+func testReview(username string, hours int) Review {
+	return Review{Username: username, ReviewedAt: testStart.Add(time.Duration(hours) * time.Hour)}
+}
+
+// timelineTitles returns recorded titles of merge request with recording hours, i.e. "draft@0"
+func timelineTitles(t *testing.T, s *Service, iid int64) []string {
+	t.Helper()
+
+	_, states, err := s.GetTimeline(context.Background(), MergeRequestKey{ProjectID: 1, IID: iid})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return lo.Map(states, func(item MergeRequestState, _ int) string {
+		return item.Title + "@" + item.RecordedAt.Sub(testStart).String()
+	})
+}
+
+func reviewsOf(t *testing.T, s *Service, iid int64) []Review {
+	t.Helper()
+
+	rows, err := s.db.Query(`SELECT username, reviewed_at FROM merge_request_reviews WHERE iid = ? ORDER BY username`, iid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var res []Review
+	for rows.Next() {
+		var (
+			r          Review
+			reviewedAt int64
+		)
+		if err = rows.Scan(&r.Username, &reviewedAt); err != nil {
+			t.Fatal(err)
+		}
+		r.ReviewedAt = time.UnixMilli(reviewedAt).UTC()
+		res = append(res, r)
+	}
+	return res
+}
+
+func TestRecordStates(t *testing.T) {
+	tests := []struct {
+		name        string
+		batches     [][]Record
+		reopen      bool // database is reopened before the last batch
+		wantStates  []string
+		wantReviews []Review
+	}{
+		{
+			name: "the first state is recorded",
+			batches: [][]Record{
+				{testRecord(1, 0, openedState, "draft")},
+			},
+			wantStates: []string{"draft@0s"},
+		},
+		{
+			name: "unchanged state is not recorded again",
+			batches: [][]Record{
+				{testRecord(1, 0, openedState, "draft")},
+				{testRecord(1, 1, openedState, "draft")},
+				{testRecord(1, 2, openedState, "final")},
+			},
+			wantStates: []string{"draft@0s", "final@2h0m0s"},
+		},
+		{
+			name: "recorded states survive restart",
+			batches: [][]Record{
+				{testRecord(1, 0, openedState, "draft")},
+				{testRecord(1, 1, openedState, "draft")},
+			},
+			reopen:     true,
+			wantStates: []string{"draft@0s"},
+		},
+		{
+			name: "the earliest review is kept",
+			batches: [][]Record{
+				{testRecord(1, 0, openedState, "draft", testReview("bob", 5))},
+				{testRecord(1, 1, openedState, "draft", testReview("bob", 3), testReview("carol", 6))},
+				{testRecord(1, 2, openedState, "draft", testReview("bob", 4))},
+			},
+			wantStates:  []string{"draft@0s"},
+			wantReviews: []Review{testReview("bob", 3), testReview("carol", 6)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			db := openTestDB(t)
+			s, err := newService(ctx, db)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for i, batch := range tt.batches {
+				if tt.reopen && i == len(tt.batches)-1 {
+					if s, err = newService(ctx, db); err != nil {
+						t.Fatal(err)
+					}
+				}
+				if err = s.RecordStates(ctx, batch); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if got := timelineTitles(t, s, 1); !slices.Equal(got, tt.wantStates) {
+				t.Errorf("recorded states = %v, want %v", got, tt.wantStates)
+			}
+			if got := reviewsOf(t, s, 1); !slices.EqualFunc(got, tt.wantReviews, func(a, b Review) bool {
+				return a.Username == b.Username && a.ReviewedAt.Equal(b.ReviewedAt)
+			}) {
+				t.Errorf("recorded reviews = %v, want %v", got, tt.wantReviews)
+			}
+		})
+	}
+}
+
+func TestRecordFinalStates(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+
+	if err := s.RecordStates(ctx, []Record{
+		testRecord(1, 0, openedState, "opened"),
+		testRecord(2, 0, openedState, "opened"),
+		testRecord(3, 0, "closed", "closed"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	opened := s.OpenedMergeRequests()
+	slices.SortFunc(opened, func(a, b MergeRequestKey) int { return int(a.IID - b.IID) })
+	if want := []MergeRequestKey{{ProjectID: 1, IID: 1}, {ProjectID: 1, IID: 2}}; !slices.Equal(opened, want) {
+		t.Fatalf("OpenedMergeRequests() = %v, want %v", opened, want)
+	}
+
+	mergedAt := testStart.Add(90 * time.Minute)
+	if err := s.RecordFinalStates(ctx, testStart.Add(2*time.Hour), map[MergeRequestKey]FinalState{
+		{ProjectID: 1, IID: 1}: {State: "merged", MergedAt: mergedAt},
+		{ProjectID: 1, IID: 3}: {State: "merged"}, // not opened, so it is left as is
+		{ProjectID: 1, IID: 4}: {State: "closed"}, // unknown
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, states, err := s.GetTimeline(ctx, MergeRequestKey{ProjectID: 1, IID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := lo.Map(states, func(item MergeRequestState, _ int) string { return item.State + "/" + item.Title })
+	if want := []string{"opened/opened", "merged/opened"}; !slices.Equal(got, want) {
+		t.Errorf("states of merged merge request = %v, want %v", got, want)
+	}
+	var gotMergedAt int64
+	if err = s.db.QueryRowContext(ctx, `SELECT merged_at FROM merge_requests WHERE iid = 1`).Scan(&gotMergedAt); err != nil {
+		t.Fatal(err)
+	}
+	if gotMergedAt != mergedAt.UnixMilli() {
+		t.Errorf("merge time = %v, want %v", time.UnixMilli(gotMergedAt).UTC(), mergedAt)
+	}
+	if got := timelineTitles(t, s, 3); !slices.Equal(got, []string{"closed@0s"}) {
+		t.Errorf("states of closed merge request = %v, want the only one", got)
+	}
+	if opened = s.OpenedMergeRequests(); !slices.Equal(opened, []MergeRequestKey{{ProjectID: 1, IID: 2}}) {
+		t.Errorf("OpenedMergeRequests() after merge = %v, want the second one only", opened)
+	}
+}
+
+func TestGetTimeline(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+
+	first := testRecord(1, 0, openedState, "draft")
+	first.State.ApprovedBy = []string{"bob"}
+	first.State.UnresolvedThreads = 2
+	if err := s.RecordStates(ctx, []Record{first}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.RecordStates(ctx, []Record{testRecord(1, 1, openedState, "final")}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		key        MergeRequestKey
+		wantMR     *MergeRequest
+		wantStates []MergeRequestState
+	}{
+		{
+			name:   "no history",
+			key:    MergeRequestKey{ProjectID: 1, IID: 100},
+			wantMR: nil,
+		},
+		{
+			name:       "states in recording order",
+			key:        MergeRequestKey{ProjectID: 1, IID: 1},
+			wantMR:     &first.MergeRequest,
+			wantStates: []MergeRequestState{first.State, testRecord(1, 1, openedState, "final").State},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr, states, err := s.GetTimeline(ctx, tt.key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (mr == nil) != (tt.wantMR == nil) {
+				t.Fatalf("GetTimeline() merge request = %+v, want %+v", mr, tt.wantMR)
+			}
+			if mr != nil && (mr.MergeRequestKey != tt.wantMR.MergeRequestKey || mr.URL != tt.wantMR.URL ||
+				mr.Author != tt.wantMR.Author || !mr.CreatedAt.Equal(tt.wantMR.CreatedAt)) {
+				t.Errorf("GetTimeline() merge request = %+v, want %+v", mr, tt.wantMR)
+			}
+			if !slices.EqualFunc(states, tt.wantStates, func(a, b MergeRequestState) bool {
+				return a.RecordedAt.Equal(b.RecordedAt) && a.sameAs(b)
+			}) {
+				t.Errorf("GetTimeline() states = %+v, want %+v", states, tt.wantStates)
+			}
+		})
+	}
+}
+
+func TestDeleteHistoryBefore(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+
+	for _, batch := range [][]Record{
+		{
+			testRecord(1, 0, openedState, "old", testReview("bob", 0)),
+			testRecord(2, 0, openedState, "old", testReview("bob", 0)),
+			testRecord(3, 0, openedState, "old"),
+		},
+		{
+			testRecord(1, 1, openedState, "newer"),
+			testRecord(2, 1, "merged", "old"),
+		},
+		{
+			testRecord(1, 10, openedState, "recent"),
+		},
+	} {
+		if err := s.RecordStates(ctx, batch); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := s.deleteHistoryBefore(ctx, testStart.Add(5*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		iid         int64
+		wantStates  []string
+		wantReviews int
+	}{
+		{
+			name:        "old states of opened merge request are deleted",
+			iid:         1,
+			wantStates:  []string{"recent@10h0m0s"},
+			wantReviews: 1,
+		},
+		{
+			name:        "merged merge request is deleted with its reviews",
+			iid:         2,
+			wantStates:  nil,
+			wantReviews: 0,
+		},
+		{
+			name:       "the latest state of opened merge request is kept",
+			iid:        3,
+			wantStates: []string{"old@0s"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timelineTitles(t, s, tt.iid); !slices.Equal(got, tt.wantStates) {
+				t.Errorf("states = %v, want %v", got, tt.wantStates)
+			}
+			if got := len(reviewsOf(t, s, tt.iid)); got != tt.wantReviews {
+				t.Errorf("reviews = %d, want %d", got, tt.wantReviews)
+			}
+		})
+	}
+
+	// merge request is forgotten, so it would be recorded as new one
+	if err := s.RecordStates(ctx, []Record{testRecord(2, 20, "merged", "old")}); err != nil {
+		t.Fatal(err)
+	}
+	if got := timelineTitles(t, s, 2); !slices.Equal(got, []string{"old@20h0m0s"}) {
+		t.Errorf("states of forgotten merge request = %v, want it recorded again", got)
+	}
+}
@@ -0,0 +1,127 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// GetMarks returns marks of all merge requests which have them
+func (s *Service) GetMarks(ctx context.Context) (map[MergeRequestKey]Mark, error) {
+	rows, err := s.db.QueryContext(ctx, `
+SELECT project_id, iid, seen_activity, snoozed_until, snoozed_activity, pinned FROM merge_request_marks`)
+	if err != nil {
+		return nil, fmt.Errorf("could not get merge request marks: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := make(map[MergeRequestKey]Mark)
+	for rows.Next() {
+		var (
+			key          MergeRequestKey
+			mark         Mark
+			snoozedUntil int64
+		)
+		if err = rows.Scan(
+			&key.ProjectID, &key.IID, &mark.SeenActivity, &snoozedUntil, &mark.SnoozedActivity, &mark.Pinned,
+		); err != nil {
+			return nil, fmt.Errorf("could not get merge request marks: %w", err)
+		}
+		mark.SnoozedUntil = fromUnixMilli(snoozedUntil)
+		res[key] = mark
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not get merge request marks: %w", err)
+	}
+	return res, nil
+}
+
+// UpdateMark applies update to the current mark of merge request and saves the result,
+// mark becoming empty is deleted
+func (s *Service) UpdateMark(ctx context.Context, key MergeRequestKey, update func(Mark) Mark) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not update merge request mark: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var (
+		mark         Mark
+		snoozedUntil int64
+	)
+	err = tx.QueryRowContext(ctx, `
+SELECT seen_activity, snoozed_until, snoozed_activity, pinned FROM merge_request_marks WHERE project_id = ? AND iid = ?`,
+		key.ProjectID, key.IID,
+	).Scan(&mark.SeenActivity, &snoozedUntil, &mark.SnoozedActivity, &mark.Pinned)
+	if err != nil && err != sql.ErrNoRows {
+		return fmt.Errorf("could not update merge request mark: %w", err)
+	}
+	mark.SnoozedUntil = fromUnixMilli(snoozedUntil)
+
+	mark = update(mark)
+	if mark.empty() {
+		_, err = tx.ExecContext(ctx, `DELETE FROM merge_request_marks WHERE project_id = ? AND iid = ?`,
+			key.ProjectID, key.IID,
+		)
+	} else {
+		_, err = tx.ExecContext(ctx, `
+INSERT OR REPLACE INTO merge_request_marks (project_id, iid, seen_activity, snoozed_until, snoozed_activity, pinned)
+VALUES (?, ?, ?, ?, ?, ?)`,
+			key.ProjectID, key.IID, mark.SeenActivity, toUnixMilli(mark.SnoozedUntil), mark.SnoozedActivity, mark.Pinned,
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("could not update merge request mark: %w", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not update merge request mark: %w", err)
+	}
+	return nil
+}
+
+// deleteStaleMarks drops expired snoozes and deletes marks of merge requests which were merged or closed
+// according to their latest recorded state, marks of merge requests without recorded history are kept
+func (s *Service) deleteStaleMarks(ctx context.Context, now time.Time) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err = tx.ExecContext(ctx, `
+UPDATE merge_request_marks SET snoozed_until = 0 WHERE snoozed_until != 0 AND snoozed_until <= ?`,
+		now.UnixMilli(),
+	); err != nil {
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, `
+DELETE FROM merge_request_marks
+WHERE seen_activity = '' AND snoozed_until = 0 AND snoozed_activity = '' AND pinned = 0`); err != nil {
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, `
+DELETE FROM merge_request_marks AS m
+WHERE (
+    SELECT state FROM merge_request_states WHERE project_id = m.project_id AND iid = m.iid
+    ORDER BY recorded_at DESC LIMIT 1
+) != 'opened'`); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// toUnixMilli keeps zero time as 0, so it is read back as zero time
+func toUnixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+func fromUnixMilli(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
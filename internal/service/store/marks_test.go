@@ -0,0 +1,156 @@
+package store
+
+import (
+	"context"
+	"maps"
+	"testing"
+	"time"
+)
+
+func TestUpdateMark(t *testing.T) {
+	key := MergeRequestKey{ProjectID: 1, IID: 1}
+	snoozedUntil := testStart.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		updates []func(Mark) Mark
+		want    map[MergeRequestKey]Mark
+	}{
+		{
+			name: "mark is created",
+			updates: []func(Mark) Mark{
+				func(m Mark) Mark { m.SeenActivity = "sha/1/"; return m },
+			},
+			want: map[MergeRequestKey]Mark{key: {SeenActivity: "sha/1/"}},
+		},
+		{
+			name: "update gets the current mark",
+			updates: []func(Mark) Mark{
+				func(m Mark) Mark { m.Pinned = true; return m },
+				func(m Mark) Mark { m.SnoozedUntil = snoozedUntil; return m },
+			},
+			want: map[MergeRequestKey]Mark{key: {Pinned: true, SnoozedUntil: snoozedUntil}},
+		},
+		{
+			name: "empty mark is deleted",
+			updates: []func(Mark) Mark{
+				func(m Mark) Mark { m.Pinned = true; return m },
+				func(m Mark) Mark { m.Pinned = false; return m },
+			},
+			want: map[MergeRequestKey]Mark{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestService(t)
+
+			for _, update := range tt.updates {
+				if err := s.UpdateMark(ctx, key, update); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := s.GetMarks(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !maps.EqualFunc(got, tt.want, func(a, b Mark) bool {
+				return a.SeenActivity == b.SeenActivity && a.SnoozedActivity == b.SnoozedActivity &&
+					a.Pinned == b.Pinned && a.SnoozedUntil.Equal(b.SnoozedUntil)
+			}) {
+				t.Errorf("GetMarks() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeleteStaleMarks(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	now := testStart.Add(10 * time.Hour)
+
+	const (
+		noHistory = iota + 1
+		agedOut
+		opened
+		merged
+		closed
+		snoozeExpired
+		snoozeExpiredPinned
+		snoozeActive
+	)
+	if err := s.RecordStates(ctx, []Record{
+		testRecord(agedOut, -2, openedState, "old"),
+		testRecord(opened, 0, openedState, "opened"),
+		testRecord(merged, 0, openedState, "opened"),
+		testRecord(closed, 0, openedState, "opened"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.RecordStates(ctx, []Record{
+		testRecord(agedOut, -1, "merged", "old"),
+		testRecord(merged, 1, "merged", "opened"),
+		testRecord(closed, 1, "closed", "opened"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	marks := map[int64]Mark{
+		noHistory:           {SeenActivity: "a", Pinned: true},
+		agedOut:             {Pinned: true},
+		opened:              {SeenActivity: "a", SnoozedActivity: "a", Pinned: true},
+		merged:              {SeenActivity: "a"},
+		closed:              {Pinned: true},
+		snoozeExpired:       {SnoozedUntil: now.Add(-time.Minute)},
+		snoozeExpiredPinned: {SnoozedUntil: now.Add(-time.Minute), Pinned: true},
+		snoozeActive:        {SnoozedUntil: now.Add(time.Minute)},
+	}
+	for iid, mark := range marks {
+		if err := s.UpdateMark(ctx, MergeRequestKey{ProjectID: 1, IID: iid}, func(Mark) Mark { return mark }); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// history of merged merge request is older than retention, its mark is not affected
+	if err := s.deleteHistoryBefore(ctx, testStart); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.deleteStaleMarks(ctx, now); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := s.GetMarks(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		iid  int64
+		want *Mark
+	}{
+		{name: "merge request without history", iid: noHistory, want: &Mark{SeenActivity: "a", Pinned: true}},
+		{name: "merge request which history aged out", iid: agedOut, want: &Mark{Pinned: true}},
+		{name: "opened merge request", iid: opened, want: &Mark{SeenActivity: "a", SnoozedActivity: "a", Pinned: true}},
+		{name: "merged merge request", iid: merged, want: nil},
+		{name: "closed merge request", iid: closed, want: nil},
+		{name: "expired snooze", iid: snoozeExpired, want: nil},
+		{name: "expired snooze of pinned merge request", iid: snoozeExpiredPinned, want: &Mark{Pinned: true}},
+		{name: "active snooze", iid: snoozeActive, want: &Mark{SnoozedUntil: now.Add(time.Minute)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mark, found := got[MergeRequestKey{ProjectID: 1, IID: tt.iid}]
+			if found != (tt.want != nil) {
+				t.Fatalf("mark found = %v, want %v", found, tt.want != nil)
+			}
+			if found && (mark.SeenActivity != tt.want.SeenActivity || mark.SnoozedActivity != tt.want.SnoozedActivity ||
+				mark.Pinned != tt.want.Pinned || !mark.SnoozedUntil.Equal(tt.want.SnoozedUntil)) {
+				t.Errorf("mark = %+v, want %+v", mark, *tt.want)
+			}
+		})
+	}
+}
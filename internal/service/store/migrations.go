@@ -0,0 +1,107 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// migrations are applied in order, index of migration + 1 is stored as database user_version;
+// never change applied migrations, append new ones instead
+var migrations = []string{
+	`
+CREATE TABLE merge_requests (
+    project_id   INTEGER NOT NULL,
+    iid          INTEGER NOT NULL,
+    project_name TEXT    NOT NULL,
+    url          TEXT    NOT NULL,
+    author       TEXT    NOT NULL,
+    created_at   INTEGER NOT NULL,
+    PRIMARY KEY (project_id, iid)
+);
+
+CREATE TABLE merge_request_states (
+    project_id         INTEGER NOT NULL,
+    iid                INTEGER NOT NULL,
+    recorded_at        INTEGER NOT NULL,
+    state              TEXT    NOT NULL,
+    title              TEXT    NOT NULL,
+    draft              INTEGER NOT NULL,
+    conflict           INTEGER NOT NULL,
+    pipeline_status    TEXT    NOT NULL,
+    approved_by        TEXT    NOT NULL,
+    approvals_left     INTEGER NOT NULL,
+    threads            INTEGER NOT NULL,
+    unresolved_threads INTEGER NOT NULL,
+    additions          INTEGER NOT NULL,
+    deletions          INTEGER NOT NULL,
+    PRIMARY KEY (project_id, iid, recorded_at)
+);
+
+CREATE INDEX merge_request_states_recorded_at ON merge_request_states (recorded_at);
+`,
+	`
+CREATE TABLE merge_request_reviews (
+    project_id  INTEGER NOT NULL,
+    iid         INTEGER NOT NULL,
+    username    TEXT    NOT NULL,
+    reviewed_at INTEGER NOT NULL,
+    PRIMARY KEY (project_id, iid, username)
+);
+
+CREATE INDEX merge_request_reviews_reviewed_at ON merge_request_reviews (reviewed_at);
+`,
+	`
+ALTER TABLE merge_requests ADD COLUMN merged_at INTEGER NOT NULL DEFAULT 0;
+`,
+	`
+CREATE TABLE merge_request_marks (
+    project_id       INTEGER NOT NULL,
+    iid              INTEGER NOT NULL,
+    seen_activity    TEXT    NOT NULL,
+    snoozed_until    INTEGER NOT NULL,
+    snoozed_activity TEXT    NOT NULL,
+    pinned           INTEGER NOT NULL,
+    PRIMARY KEY (project_id, iid)
+);
+`,
+	`
+CREATE TABLE merge_request_annotations (
+    project_id INTEGER NOT NULL,
+    iid        INTEGER NOT NULL,
+    note       TEXT    NOT NULL,
+    tags       TEXT    NOT NULL,
+    updated_at INTEGER NOT NULL,
+    PRIMARY KEY (project_id, iid)
+);
+`,
+}
+
+func migrate(ctx context.Context, db *sql.DB) error {
+	var version int
+	if err := db.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version); err != nil {
+		return fmt.Errorf("could not get schema version: %w", err)
+	}
+	if version > len(migrations) {
+		return fmt.Errorf("schema version %d is newer than supported %d", version, len(migrations))
+	}
+
+	for i := version; i < len(migrations); i++ {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if _, err = tx.ExecContext(ctx, migrations[i]); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("could not apply migration %d: %w", i+1, err)
+		}
+		if _, err = tx.ExecContext(ctx, fmt.Sprintf("PRAGMA user_version = %d", i+1)); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("could not apply migration %d: %w", i+1, err)
+		}
+		if err = tx.Commit(); err != nil {
+			return fmt.Errorf("could not apply migration %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
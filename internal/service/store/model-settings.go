@@ -0,0 +1,9 @@
+package store
+
+import "time"
+
+const defaultRetention = 90 * 24 * time.Hour
+
+type Settings struct {
+	Retention time.Duration // history older than retention is deleted, defaultRetention is used when zero
+}
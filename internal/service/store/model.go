@@ -0,0 +1,104 @@
+package store
+
+import (
+	"slices"
+	"time"
+)
+
+type MergeRequestKey struct {
+	ProjectID int64
+	IID       int64
+}
+
+type MergeRequest struct {
+	MergeRequestKey
+	ProjectName string
+	URL         string
+	Author      string
+	CreatedAt   time.Time
+	MergedAt    time.Time // reported by gitlab, zero when merge request is not merged or time is unknown
+}
+
+// MergeRequestState is a state of merge request at some moment, only changed states are recorded
+type MergeRequestState struct {
+	RecordedAt        time.Time
+	State             string // opened, merged or closed
+	Title             string
+	Draft             bool
+	Conflict          bool
+	PipelineStatus    string
+	ApprovedBy        []string // usernames
+	ApprovalsLeft     int
+	Threads           int // resolvable threads
+	UnresolvedThreads int
+	Additions         int64
+	Deletions         int64
+}
+
+// Review is the first comment or approval of merge request given by reviewer
+type Review struct {
+	Username   string
+	ReviewedAt time.Time
+}
+
+// Record is a merge request state observed on snapshot refresh
+type Record struct {
+	MergeRequest MergeRequest
+	State        MergeRequestState
+	Reviews      []Review
+}
+
+// AnalyticsRecord has merge request data needed to compute review analytics
+type AnalyticsRecord struct {
+	MergeRequest MergeRequest
+	MergedAt     time.Time // reported by gitlab or, when unknown, when merge was recorded; zero when not merged
+	Additions    int64     // the latest known diff size
+	Deletions    int64
+	Reviews      []Review
+}
+
+// Mark is local state of merge request set by user, activity is a fingerprint of merge request commits,
+// comments and approvals, so marks which depend on it are cleared by any new activity
+type Mark struct {
+	SeenActivity    string    // activity when merge request was marked as seen, empty when it is not seen
+	SnoozedUntil    time.Time // zero when merge request is not snoozed until date
+	SnoozedActivity string    // activity when merge request was snoozed until the next change, empty when it is not
+	Pinned          bool
+}
+
+func (m Mark) empty() bool {
+	return m == Mark{}
+}
+
+// FinalState is a state of merge request which is not opened anymore
+type FinalState struct {
+	State    string    // merged or closed
+	MergedAt time.Time // reported by gitlab, zero when unknown
+}
+
+// Annotation is private note and tags attached to merge request by user, unlike history and marks
+// it is never cleaned up
+type Annotation struct {
+	Note      string // markdown
+	Tags      []string
+	UpdatedAt time.Time
+}
+
+func (a Annotation) empty() bool {
+	return len(a.Note) == 0 && len(a.Tags) == 0
+}
+
+// sameAs reports whether states are equal regardless of recording time
+func (s MergeRequestState) sameAs(other MergeRequestState) bool {
+	return s.State == other.State &&
+		s.Title == other.Title &&
+		s.Draft == other.Draft &&
+		s.Conflict == other.Conflict &&
+		s.PipelineStatus == other.PipelineStatus &&
+		slices.Equal(s.ApprovedBy, other.ApprovedBy) &&
+		s.ApprovalsLeft == other.ApprovalsLeft &&
+		s.Threads == other.Threads &&
+		s.UnresolvedThreads == other.UnresolvedThreads &&
+		s.Additions == other.Additions &&
+		s.Deletions == other.Deletions
+}
@@ -0,0 +1,110 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	_ "modernc.org/sqlite" // registers sqlite driver
+)
+
+const cleanupInterval = time.Hour
+
+// Service keeps local state of the application in embedded SQLite database
+type Service struct {
+	db *sql.DB
+
+	settings   Settings
+	settingsMx sync.Mutex
+
+	// latestStates and knownReviews are already recorded, they are used to record changes only
+	latestStates map[MergeRequestKey]MergeRequestState
+	knownReviews map[MergeRequestKey]map[string]time.Time
+	historyMx    sync.Mutex
+}
+
+// NewService opens database at path, creating it when missing, and migrates it to the latest schema
+func NewService(ctx context.Context, path string) (*Service, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("could not create database directory: %w", err)
+	}
+
+	db, err := sql.Open("sqlite", "file:"+path+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)")
+	if err != nil {
+		return nil, fmt.Errorf("could not open database %s: %w", path, err)
+	}
+	// sqlite allows single writer only, so concurrent writes would fail with "database is locked"
+	db.SetMaxOpenConns(1)
+
+	s, err := newService(ctx, db)
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("database %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// newService migrates opened database and loads already recorded state
+func newService(ctx context.Context, db *sql.DB) (*Service, error) {
+	if err := migrate(ctx, db); err != nil {
+		return nil, fmt.Errorf("could not migrate: %w", err)
+	}
+
+	s := &Service{db: db}
+	var err error
+	if s.latestStates, err = s.loadLatestStates(ctx); err != nil {
+		return nil, err
+	}
+	if s.knownReviews, err = s.loadReviews(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Service) UpdateSettings(settings Settings) {
+	s.settingsMx.Lock()
+	defer s.settingsMx.Unlock()
+	s.settings = settings
+}
+
+func (s *Service) getRetention() time.Duration {
+	s.settingsMx.Lock()
+	defer s.settingsMx.Unlock()
+	if s.settings.Retention > 0 {
+		return s.settings.Retention
+	}
+	return defaultRetention
+}
+
+// Start runs periodic cleanup of stale marks and history older than retention until ctx is done
+func (s *Service) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(cleanupInterval)
+		defer ticker.Stop()
+
+		for {
+			// marks go first, since closed merge requests are found by their recorded states
+			if err := s.deleteStaleMarks(ctx, time.Now()); err != nil {
+				log.Printf("marks cleanup failed: %v", err)
+			}
+			if err := s.deleteHistoryBefore(ctx, time.Now().Add(-s.getRetention())); err != nil {
+				log.Printf("history cleanup failed: %v", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *Service) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,124 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+)
+
+// openTestDB opens in-memory database, single connection keeps it alive until the test ends
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func newTestService(t *testing.T) *Service {
+	t.Helper()
+
+	s, err := newService(context.Background(), openTestDB(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// migrateTo applies migrations up to given schema version, as older application would do
+func migrateTo(t *testing.T, db *sql.DB, version int) {
+	t.Helper()
+
+	for i, m := range migrations[:version] {
+		if _, err := db.Exec(m); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := db.Exec(fmt.Sprintf("PRAGMA user_version = %d", i+1)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		prepare func(t *testing.T, db *sql.DB)
+		wantErr bool
+		check   func(t *testing.T, db *sql.DB)
+	}{
+		{
+			name:    "new database",
+			prepare: func(*testing.T, *sql.DB) {},
+		},
+		{
+			name: "already migrated database",
+			prepare: func(t *testing.T, db *sql.DB) {
+				if err := migrate(ctx, db); err != nil {
+					t.Fatal(err)
+				}
+			},
+		},
+		{
+			name: "merge time is added to recorded merge requests",
+			prepare: func(t *testing.T, db *sql.DB) {
+				migrateTo(t, db, 2)
+				if _, err := db.ExecContext(ctx, `
+INSERT INTO merge_requests (project_id, iid, project_name, url, author, created_at) VALUES (1, 1, 'a', 'u', 'alice', 1)`,
+				); err != nil {
+					t.Fatal(err)
+				}
+			},
+			check: func(t *testing.T, db *sql.DB) {
+				var mergedAt int64
+				if err := db.QueryRowContext(ctx, "SELECT merged_at FROM merge_requests WHERE iid = 1").Scan(&mergedAt); err != nil {
+					t.Fatal(err)
+				}
+				if mergedAt != 0 {
+					t.Errorf("merged_at = %d, want 0", mergedAt)
+				}
+			},
+		},
+		{
+			name: "database of newer version",
+			prepare: func(t *testing.T, db *sql.DB) {
+				if _, err := db.ExecContext(ctx, "PRAGMA user_version = 1000"); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := openTestDB(t)
+			tt.prepare(t, db)
+
+			err := migrate(ctx, db)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("migrate() error = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var version int
+			if err = db.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version); err != nil {
+				t.Fatal(err)
+			}
+			if version != len(migrations) {
+				t.Errorf("user_version = %d, want %d", version, len(migrations))
+			}
+			if tt.check != nil {
+				tt.check(t, db)
+			}
+		})
+	}
+}
@@ -3,18 +3,121 @@ package request
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"sync"
+	"time"
 )
 
-func GET(ctx context.Context, url string, headers map[string]string) ([]byte, error) {
-	return doRequest(ctx, http.MethodGet, url, headers, nil)
+const (
+	defaultTimeout    = 30 * time.Second
+	defaultMaxRetries = 3
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// StatusError is returned when server responds with unexpected HTTP status code
+type StatusError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Status     string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%q request to %s failed with code %s", e.Method, e.URL, e.Status)
+}
+
+type Settings struct {
+	// Timeout limits every single attempt of the request
+	Timeout time.Duration
+	// MaxRetries is the number of repeated attempts after retryable failures: network errors, 429 and 5xx codes;
+	// default is used when not set, negative value disables retries
+	MaxRetries int
+	// MinBackoff and MaxBackoff bound exponential delay between attempts
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// QPS limits rate of requests made by the client, unlimited when not set
+	QPS float64
+	// MaxConcurrency limits number of requests executed by the client simultaneously, unlimited when not set
+	MaxConcurrency int
+}
+
+func (s Settings) withDefaults() Settings {
+	if s.Timeout <= 0 {
+		s.Timeout = defaultTimeout
+	}
+	if s.MaxRetries < 0 {
+		s.MaxRetries = 0
+	} else if s.MaxRetries == 0 {
+		s.MaxRetries = defaultMaxRetries
+	}
+	if s.MinBackoff <= 0 {
+		s.MinBackoff = defaultMinBackoff
+	}
+	if s.MaxBackoff < s.MinBackoff {
+		s.MaxBackoff = max(defaultMaxBackoff, s.MinBackoff)
+	}
+	return s
 }
 
-func POST(ctx context.Context, url string, headers map[string]string, body []byte) ([]byte, error) {
-	return doRequest(ctx, http.MethodPost, url, headers, io.NopCloser(bytes.NewBuffer(body)))
+// Client makes HTTP requests with retries, backoff and limits shared by all its users
+type Client struct {
+	httpClient *http.Client
+	limiter    *limiter
+
+	settings   Settings
+	settingsMx sync.RWMutex
+}
+
+func NewClient(settings Settings) *Client {
+	c := &Client{
+		httpClient: &http.Client{},
+		limiter:    newLimiter(),
+	}
+	c.UpdateSettings(settings)
+	return c
+}
+
+func (c *Client) UpdateSettings(settings Settings) {
+	settings = settings.withDefaults()
+
+	c.settingsMx.Lock()
+	defer c.settingsMx.Unlock()
+	c.settings = settings
+	c.limiter.update(settings.QPS, settings.MaxConcurrency)
+}
+
+func (c *Client) getSettings() Settings {
+	c.settingsMx.RLock()
+	defer c.settingsMx.RUnlock()
+	return c.settings
+}
+
+func (c *Client) GET(ctx context.Context, url string, headers map[string]string) ([]byte, error) {
+	data, _, err := c.doRequest(ctx, http.MethodGet, url, headers, nil)
+	return data, err
+}
+
+// GETWithHeaders works like GET but also returns response headers, i.e. for following pagination links
+func (c *Client) GETWithHeaders(ctx context.Context, url string, headers map[string]string) ([]byte, http.Header, error) {
+	return c.doRequest(ctx, http.MethodGet, url, headers, nil)
+}
+
+// POST is retried the same way as GET, so it is meant for requests without side effects, i.e. GraphQL queries
+func (c *Client) POST(ctx context.Context, url string, headers map[string]string, body []byte) ([]byte, error) {
+	data, _, err := c.doRequest(ctx, http.MethodPost, url, headers, body)
+	return data, err
+}
+
+// Send makes request with side effects, it is retried only when server rejected it because of rate limit;
+// response body is returned along with StatusError, so the caller can get error details from it
+func (c *Client) Send(ctx context.Context, method string, url string, headers map[string]string, body []byte) ([]byte, error) {
+	data, _, err := c.doRequestWithRetry(ctx, method, url, headers, body, isRateLimited)
+	return data, err
 }
 
 func MustURL(hostWithSchemaAndPath string, queryKV ...string) string {
@@ -38,29 +141,120 @@ func MustURL(hostWithSchemaAndPath string, queryKV ...string) string {
 	return u.String()
 }
 
-func doRequest(ctx context.Context, method string, url string, headers map[string]string, body io.ReadCloser) ([]byte, error) {
+func (c *Client) doRequest(
+	ctx context.Context, method string, url string, headers map[string]string, body []byte,
+) ([]byte, http.Header, error) {
+	return c.doRequestWithRetry(ctx, method, url, headers, body, isRetryable)
+}
+
+func (c *Client) doRequestWithRetry(
+	ctx context.Context, method string, url string, headers map[string]string, body []byte,
+	retryable func(ctx context.Context, err error) bool,
+) ([]byte, http.Header, error) {
+	settings := c.getSettings()
+
+	for attempt := 0; ; attempt++ {
+		data, header, err := c.doAttempt(ctx, settings.Timeout, method, url, headers, body)
+		if err == nil {
+			return data, header, nil
+		}
+
+		if attempt >= settings.MaxRetries || !retryable(ctx, err) {
+			return data, header, err
+		}
+
+		delay := backoff(attempt, settings.MinBackoff, settings.MaxBackoff)
+		if wait, found := rateLimitWait(header, time.Now()); found {
+			// server told exactly when it is ready to accept requests again, all the client requests should wait
+			c.limiter.pauseFor(wait)
+			delay = wait
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil, fmt.Errorf("%q request to %s: %w (last error: %w)", method, url, ctx.Err(), err)
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (c *Client) doAttempt(
+	ctx context.Context, timeout time.Duration, method string, url string, headers map[string]string, body []byte,
+) ([]byte, http.Header, error) {
+	release, err := c.limiter.acquire(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%q request to %s: wait for rate limiter: %w", method, url, err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	req, _ := http.NewRequestWithContext(ctx, method, url, nil)
 	for k, v := range headers {
 		req.Header.Add(k, v)
 	}
 
-	req.Body = body
+	if body != nil {
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := c.httpClient.Do(req)
 
 	if err != nil {
-		return nil, fmt.Errorf("GET request to %s error: %w", url, err)
+		return nil, nil, fmt.Errorf("%q request to %s error: %w", method, url, err)
 	}
 
 	defer func() { _ = resp.Body.Close() }()
 	data, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("read response from %s: %w", url, err)
+		return nil, nil, fmt.Errorf("read response from %s: %w", url, err)
+	}
+
+	if wait, found := rateLimitExhausted(resp.Header, time.Now()); found {
+		c.limiter.pauseFor(wait)
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return data, resp.Header, &StatusError{
+			Method:     method,
+			URL:        url,
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+		}
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return data, fmt.Errorf("%q request to %s failed with code %s", method, url, resp.Status)
+	return data, resp.Header, nil
+}
+
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		switch statusErr.StatusCode {
+		case http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return true
+		default:
+			return false
+		}
+	}
+
+	// network errors and timeouts of a single attempt
+	return true
+}
+
+func isRateLimited(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
 	}
 
-	return data, nil
+	var statusErr *StatusError
+	return errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusTooManyRequests
 }
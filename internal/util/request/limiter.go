@@ -0,0 +1,89 @@
+package request
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// limiter restricts rate and concurrency of requests, it can also be paused when server asks to slow down
+type limiter struct {
+	mx          sync.Mutex
+	rate        *rate.Limiter
+	sem         chan struct{}
+	pausedUntil time.Time
+}
+
+func newLimiter() *limiter {
+	return &limiter{
+		rate: rate.NewLimiter(rate.Inf, 1),
+	}
+}
+
+func (l *limiter) update(qps float64, maxConcurrency int) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if qps > 0 {
+		l.rate.SetLimit(rate.Limit(qps))
+		l.rate.SetBurst(max(1, int(qps)))
+	} else {
+		l.rate.SetLimit(rate.Inf)
+	}
+
+	if maxConcurrency > 0 {
+		if cap(l.sem) != maxConcurrency {
+			l.sem = make(chan struct{}, maxConcurrency)
+		}
+	} else {
+		l.sem = nil
+	}
+}
+
+func (l *limiter) pauseFor(d time.Duration) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if until := time.Now().Add(d); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// acquire blocks until request is allowed to run, returned func must be called when request is done
+func (l *limiter) acquire(ctx context.Context) (func(), error) {
+	l.mx.Lock()
+	pausedUntil := l.pausedUntil
+	sem := l.sem
+	l.mx.Unlock()
+
+	if wait := time.Until(pausedUntil); wait > 0 {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+
+	if sem != nil {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case sem <- struct{}{}:
+		}
+	}
+
+	release := func() {
+		if sem != nil {
+			<-sem
+		}
+	}
+
+	if err := l.rate.Wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+
+	return release, nil
+}
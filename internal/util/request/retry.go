@@ -0,0 +1,67 @@
+package request
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	retryAfterHeader         = "Retry-After"
+	rateLimitResetHeader     = "RateLimit-Reset"
+	rateLimitRemainingHeader = "RateLimit-Remaining"
+
+	maxRateLimitWait = 5 * time.Minute
+)
+
+// backoff returns exponential delay with full jitter for the given attempt number
+func backoff(attempt int, minDelay, maxDelay time.Duration) time.Duration {
+	delay := maxDelay
+	if attempt < 32 {
+		delay = min(minDelay<<attempt, maxDelay)
+	}
+	return minDelay/2 + rand.N(delay-minDelay/2+1)
+}
+
+// rateLimitWait tells how long to wait before the next attempt according to Retry-After or RateLimit-Reset headers
+func rateLimitWait(header http.Header, now time.Time) (time.Duration, bool) {
+	if header == nil {
+		return 0, false
+	}
+
+	if v := header.Get(retryAfterHeader); len(v) > 0 {
+		if seconds, err := strconv.Atoi(v); err == nil {
+			return clampWait(time.Duration(seconds) * time.Second), true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return clampWait(t.Sub(now)), true
+		}
+	}
+
+	return resetWait(header, now)
+}
+
+// rateLimitExhausted reports whether server has no more requests left in the current rate limit window
+func rateLimitExhausted(header http.Header, now time.Time) (time.Duration, bool) {
+	if header.Get(rateLimitRemainingHeader) != "0" {
+		return 0, false
+	}
+	return resetWait(header, now)
+}
+
+func resetWait(header http.Header, now time.Time) (time.Duration, bool) {
+	v := header.Get(rateLimitResetHeader)
+	if len(v) == 0 {
+		return 0, false
+	}
+	epochSeconds, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return clampWait(time.Unix(epochSeconds, 0).Sub(now)), true
+}
+
+func clampWait(d time.Duration) time.Duration {
+	return min(max(d, 0), maxRateLimitWait)
+}
@@ -0,0 +1,210 @@
+package request
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// headers builds canonical header from key and value pairs, as it comes in response
+func headers(kv ...string) http.Header {
+	res := make(http.Header)
+	for i := 0; i+1 < len(kv); i += 2 {
+		res.Set(kv[i], kv[i+1])
+	}
+	return res
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempt  int
+		minDelay time.Duration
+		maxDelay time.Duration
+		wantMin  time.Duration
+		wantMax  time.Duration
+	}{
+		{
+			name:     "first attempt",
+			attempt:  0,
+			minDelay: 500 * time.Millisecond,
+			maxDelay: 30 * time.Second,
+			wantMin:  250 * time.Millisecond,
+			wantMax:  500 * time.Millisecond,
+		},
+		{
+			name:     "delay grows exponentially",
+			attempt:  3,
+			minDelay: 500 * time.Millisecond,
+			maxDelay: 30 * time.Second,
+			wantMin:  250 * time.Millisecond,
+			wantMax:  4 * time.Second,
+		},
+		{
+			name:     "delay is bounded",
+			attempt:  10,
+			minDelay: 500 * time.Millisecond,
+			maxDelay: 30 * time.Second,
+			wantMin:  250 * time.Millisecond,
+			wantMax:  30 * time.Second,
+		},
+		{
+			name:     "shift does not overflow",
+			attempt:  100,
+			minDelay: 500 * time.Millisecond,
+			maxDelay: 30 * time.Second,
+			wantMin:  250 * time.Millisecond,
+			wantMax:  30 * time.Second,
+		},
+		{
+			name:     "min equals max",
+			attempt:  5,
+			minDelay: time.Second,
+			maxDelay: time.Second,
+			wantMin:  500 * time.Millisecond,
+			wantMax:  time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// delay is random, so it is checked to stay within bounds on many tries
+			for range 1000 {
+				if got := backoff(tt.attempt, tt.minDelay, tt.maxDelay); got < tt.wantMin || got > tt.wantMax {
+					t.Fatalf("backoff() = %v, want within [%v, %v]", got, tt.wantMin, tt.wantMax)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimitWait(t *testing.T) {
+	now := time.Date(2026, time.March, 2, 15, 4, 5, 0, time.UTC)
+	epoch := func(t time.Time) string {
+		return strconv.FormatInt(t.Unix(), 10)
+	}
+
+	tests := []struct {
+		name   string
+		header http.Header
+		want   time.Duration
+		wantOK bool
+	}{
+		{
+			name:   "no header",
+			header: nil,
+			wantOK: false,
+		},
+		{
+			name:   "no rate limit headers",
+			header: headers("Content-Type", "application/json"),
+			wantOK: false,
+		},
+		{
+			name:   "retry after seconds",
+			header: headers(retryAfterHeader, "30"),
+			want:   30 * time.Second,
+			wantOK: true,
+		},
+		{
+			name:   "retry after date",
+			header: headers(retryAfterHeader, now.Add(time.Minute).Format(http.TimeFormat)),
+			want:   time.Minute,
+			wantOK: true,
+		},
+		{
+			name:   "retry after date in the past",
+			header: headers(retryAfterHeader, now.Add(-time.Minute).Format(http.TimeFormat)),
+			want:   0,
+			wantOK: true,
+		},
+		{
+			name:   "retry after is clamped",
+			header: headers(retryAfterHeader, "86400"),
+			want:   maxRateLimitWait,
+			wantOK: true,
+		},
+		{
+			name:   "rate limit reset",
+			header: headers(rateLimitResetHeader, epoch(now.Add(42*time.Second))),
+			want:   42 * time.Second,
+			wantOK: true,
+		},
+		{
+			name: "retry after takes precedence over rate limit reset",
+			header: headers(
+				retryAfterHeader, "5",
+				rateLimitResetHeader, epoch(now.Add(time.Minute)),
+			),
+			want:   5 * time.Second,
+			wantOK: true,
+		},
+		{
+			name: "malformed retry after falls back to rate limit reset",
+			header: headers(
+				retryAfterHeader, "soon",
+				rateLimitResetHeader, epoch(now.Add(time.Minute)),
+			),
+			want:   time.Minute,
+			wantOK: true,
+		},
+		{
+			name:   "malformed rate limit reset",
+			header: headers(rateLimitResetHeader, "soon"),
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := rateLimitWait(tt.header, now)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("rateLimitWait() = %v, %t, want %v, %t", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestRateLimitExhausted(t *testing.T) {
+	now := time.Date(2026, time.March, 2, 15, 4, 5, 0, time.UTC)
+	reset := strconv.FormatInt(now.Add(10*time.Second).Unix(), 10)
+
+	tests := []struct {
+		name   string
+		header http.Header
+		want   time.Duration
+		wantOK bool
+	}{
+		{
+			name:   "requests left",
+			header: headers(rateLimitRemainingHeader, "10", rateLimitResetHeader, reset),
+			wantOK: false,
+		},
+		{
+			name:   "no rate limit headers",
+			header: headers(),
+			wantOK: false,
+		},
+		{
+			name:   "exhausted",
+			header: headers(rateLimitRemainingHeader, "0", rateLimitResetHeader, reset),
+			want:   10 * time.Second,
+			wantOK: true,
+		},
+		{
+			name:   "exhausted without reset time",
+			header: headers(rateLimitRemainingHeader, "0"),
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := rateLimitExhausted(tt.header, now)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("rateLimitExhausted() = %v, %t, want %v, %t", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}